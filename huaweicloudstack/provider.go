@@ -27,27 +27,52 @@ import (
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/waf"
 
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/aad"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/antiddos"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/apig"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/as"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/bcs"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/bms"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/cci"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/cdm"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/cdn"
 	hcsCfw "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/cfw"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/csbs"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/cse"
 	hcsCsms "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/csms"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/css"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/dataarts"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/dc"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/deprecated"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/dis"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/dli"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/dns"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/drs"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/ecs"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/eip"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/elb"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/eps"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/evs"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/fgs"
 	hcsGaussdb "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/gaussdb"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/iec"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/ims"
 	hcsLts "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/lts"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/maas"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/modelarts"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/nat"
 	hcsObs "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/obs"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/rf"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/rms"
 	hcsRomaConnect "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/romaconnect"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/scm"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/servicestage"
 	hcsSfsturbo "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/sfsturbo"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/smn"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/sms"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/vpc"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/vpcep"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/workspace"
 )
 
 // Provider returns a schema.Provider for HuaweiCloudStack.
@@ -342,8 +367,14 @@ func Provider() *schema.Provider {
 			"hcs_cce_node":           cce.DataSourceNode(),
 			"hcs_cce_nodes":          cce.DataSourceNodes(),
 
+			"hcs_cdm_flavors": cdm.DataSourceCdmFlavors(),
+
 			"hcs_cfw_firewalls": cfw.DataSourceFirewalls(),
 
+			"hcs_css_flavors": css.DataSourceCssFlavors(),
+
+			"hcs_dc_connections": dc.DataSourceDirectConnections(),
+
 			"hcs_dcs_flavors":         dcs.DataSourceDcsFlavorsV2(),
 			"hcs_dcs_instances":       dcs.DataSourceDcsInstance(),
 			"hcs_dcs_templates":       dcs.DataSourceTemplates(),
@@ -382,6 +413,10 @@ func Provider() *schema.Provider {
 			"hcs_gaussdb_opengauss_instance":  hcsGaussdb.DataSourceOpenGaussInstance(),
 			"hcs_gaussdb_opengauss_instances": hcsGaussdb.DataSourceOpenGaussInstances(),
 
+			"hcs_iec_flavors": iec.DataSourceFlavors(),
+			"hcs_iec_images":  iec.DataSourceImages(),
+			"hcs_iec_sites":   iec.DataSourceSites(),
+
 			"hcs_ims_images": ims.DataSourceImagesImages(),
 
 			"hcs_mrs_versions": mrs.DataSourceMrsVersions(),
@@ -394,12 +429,19 @@ func Provider() *schema.Provider {
 
 			"hcs_rds_pg_plugins": rds.DataSourcePgPlugins(),
 
+			"hcs_rms_policy_definitions":  rms.DataSourcePolicyDefinitions(),
+			"hcs_rms_resource_compliance": rms.DataSourceResourceCompliance(),
+
+			"hcs_scm_certificates": scm.DataSourceCertificates(),
+
 			"hcs_sfs_file_system": sfs.DataSourceSFSFileSystemV2(),
 
 			"hcs_sfs_turbos": sfs.DataSourceTurbos(),
 
 			"hcs_smn_topics": smn.DataSourceTopics(),
 
+			"hcs_sms_source_servers": sms.DataSourceServers(),
+
 			"hcs_vpc":                    vpc.DataSourceVpcV1(),
 			"hcs_vpc_subnet":             vpc.DataSourceVpcSubnetV1(),
 			"hcs_vpc_subnet_v1":          vpc.DataSourceVpcSubnetV1(),
@@ -425,9 +467,24 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"hcs_aad_forward_rule": aad.ResourceForwardRule(),
+
 			"hcs_aom_alarm_rule":             aom.ResourceAlarmRule(),
 			"hcs_aom_service_discovery_rule": aom.ResourceServiceDiscoveryRule(),
 
+			"hcs_antiddos_basic": antiddos.ResourceCloudNativeAntiDdos(),
+
+			"hcs_apig_api":               apig.ResourceApigAPIV2(),
+			"hcs_apig_api_publishment":   apig.ResourceApigApiPublishment(),
+			"hcs_apig_application":       apig.ResourceApigApplicationV2(),
+			"hcs_apig_channel":           apig.ResourceChannel(),
+			"hcs_apig_environment":       apig.ResourceApigEnvironmentV2(),
+			"hcs_apig_group":             apig.ResourceApigGroupV2(),
+			"hcs_apig_instance":          apig.ResourceApigInstanceV2(),
+			"hcs_apig_throttling_policy": apig.ResourceApigThrottlingPolicyV2(),
+
+			"hcs_bcs_instance": bcs.ResourceInstance(),
+
 			"hcs_cce_addon":       cce.ResourceAddon(),
 			"hcs_cce_cluster":     cce.ResourceCluster(),
 			"hcs_cce_namespace":   cce.ResourceCCENamespaceV1(),
@@ -436,6 +493,18 @@ func Provider() *schema.Provider {
 			"hcs_cce_node_pool":   cce.ResourceNodePool(),
 			"hcs_cce_pvc":         cce.ResourceCcePersistentVolumeClaimsV1(),
 
+			"hcs_cci_namespace": cci.ResourceCciNamespace(),
+			"hcs_cci_network":   cci.ResourceCciNetworkV1(),
+			"hcs_cci_pvc":       cci.ResourcePersistentVolumeClaimV1(),
+
+			"hcs_cdm_cluster": cdm.ResourceCdmCluster(),
+			"hcs_cdm_job":     cdm.ResourceCdmJob(),
+			"hcs_cdm_link":    cdm.ResourceCdmLink(),
+
+			"hcs_cdn_cache_preload": cdn.ResourceCachePreload(),
+			"hcs_cdn_cache_refresh": cdn.ResourceCacheRefresh(),
+			"hcs_cdn_domain":        cdn.ResourceCdnDomainV1(),
+
 			"hcs_cfw_address_group":        cfw.ResourceAddressGroup(),
 			"hcs_cfw_black_white_list":     cfw.ResourceBlackWhiteList(),
 			"hcs_cfw_eip_protection":       cfw.ResourceEipProtection(),
@@ -444,11 +513,46 @@ func Provider() *schema.Provider {
 			"hcs_cfw_address_group_member": hcsCfw.ResourceAddressGroupMember(),
 			"hcs_cfw_protection_rule":      hcsCfw.ResourceProtectionRule(),
 
+			"hcs_csbs_backup":        csbs.ResourceCSBSBackup(),
+			"hcs_csbs_backup_policy": csbs.ResourceCSBSBackupPolicy(),
+
+			"hcs_cse_microservice":          cse.ResourceMicroservice(),
+			"hcs_cse_microservice_engine":   cse.ResourceMicroserviceEngine(),
+			"hcs_cse_microservice_instance": cse.ResourceMicroserviceInstance(),
+
+			"hcs_css_cluster":   css.ResourceCssCluster(),
+			"hcs_css_snapshot":  css.ResourceCssSnapshot(),
+			"hcs_css_thesaurus": css.ResourceCssthesaurus(),
+
+			"hcs_dataarts_studio_instance": dataarts.ResourceStudioInstance(),
+
+			"hcs_dc_virtual_gateway":   dc.ResourceVirtualGateway(),
+			"hcs_dc_virtual_interface": dc.ResourceVirtualInterface(),
+
 			"hcs_dcs_instance": dcs.ResourceDcsInstance(),
 			"hcs_dcs_backup":   dcs.ResourceDcsBackup(),
 
+			"hcs_dis_dump_task": dis.ResourceDisDumpTask(),
+			"hcs_dis_stream":    dis.ResourceDisStream(),
+
+			"hcs_dli_database":     dli.ResourceDliSqlDatabaseV1(),
+			"hcs_dli_flinksql_job": dli.ResourceFlinkSqlJob(),
+			"hcs_dli_permission":   dli.ResourceDliPermission(),
+			"hcs_dli_queue":        dli.ResourceDliQueue(),
+			"hcs_dli_spark_job":    dli.ResourceDliSparkJobV2(),
+			"hcs_dli_sql_job":      dli.ResourceSqlJob(),
+			"hcs_dli_table":        dli.ResourceDliTable(),
+
 			"hcs_csms_secret": hcsCsms.ResourceCsmsSecret(),
 
+			"hcs_iec_eip":                 iec.ResourceEip(),
+			"hcs_iec_keypair":             iec.ResourceKeypair(),
+			"hcs_iec_security_group":      iec.ResourceSecurityGroup(),
+			"hcs_iec_security_group_rule": iec.ResourceSecurityGroupRule(),
+			"hcs_iec_server":              iec.ResourceServer(),
+			"hcs_iec_vpc":                 iec.ResourceVpc(),
+			"hcs_iec_vpc_subnet":          iec.ResourceSubnet(),
+
 			"hcs_kms_key":   dew.ResourceKmsKey(),
 			"hcs_kms_grant": dew.ResourceKmsGrant(),
 
@@ -461,6 +565,8 @@ func Provider() *schema.Provider {
 			"hcs_dns_recordset": dns.ResourceDNSRecordset(),
 			"hcs_dns_zone":      dns.ResourceDNSZone(),
 
+			"hcs_drs_job": drs.ResourceDrsJob(),
+
 			"hcs_dws_cluster":            dws.ResourceDwsCluster(),
 			"hcs_dws_alarm_subscription": dws.ResourceDwsAlarmSubs(),
 			"hcs_dws_event_subscription": dws.ResourceDwsEventSubs(),
@@ -497,8 +603,14 @@ func Provider() *schema.Provider {
 
 			"hcs_enterprise_project": eps.ResourceEnterpriseProject(),
 
-			"hcs_evs_volume":   evs.ResourceEvsVolume(),
-			"hcs_evs_snapshot": evs.ResourceEvsSnapshotV2(),
+			"hcs_evs_volume":                 evs.ResourceEvsVolume(),
+			"hcs_evs_volume_transfer":        evs.ResourceVolumeTransfer(),
+			"hcs_evs_volume_transfer_accept": evs.ResourceVolumeTransferAccept(),
+			"hcs_evs_snapshot":               evs.ResourceEvsSnapshotV2(),
+
+			"hcs_fgs_dependency": fgs.ResourceFgsDependency(),
+			"hcs_fgs_function":   fgs.ResourceFgsFunctionV2(),
+			"hcs_fgs_trigger":    fgs.ResourceFunctionGraphTrigger(),
 
 			"hcs_gaussdb_opengauss_instance": hcsGaussdb.ResourceOpenGaussInstance(),
 
@@ -510,6 +622,12 @@ func Provider() *schema.Provider {
 			"hcs_lts_structuring_configuration": lts.ResourceStructConfig(),
 			"hcs_lts_transfer":                  lts.ResourceLtsTransfer(),
 
+			"hcs_maas_task": maas.ResourceMaasTask(),
+
+			"hcs_modelarts_dataset":         modelarts.ResourceDataset(),
+			"hcs_modelarts_dataset_version": modelarts.ResourceDatasetVersion(),
+			"hcs_modelarts_notebook":        modelarts.ResourceNotebook(),
+
 			"hcs_mrs_cluster": mrs.ResourceMRSClusterV2(),
 			"hcs_mrs_job":     mrs.ResourceMRSJobV2(),
 
@@ -525,8 +643,20 @@ func Provider() *schema.Provider {
 			"hcs_rds_pg_plugin":   rds.ResourceRdsPgPlugin(),
 			"hcs_rds_sql_audit":   rds.ResourceSQLAudit(),
 
+			"hcs_rf_stack": rf.ResourceStack(),
+
+			"hcs_rms_policy_assignment": rms.ResourcePolicyAssignment(),
+
 			"hcs_roma_connect_instance": hcsRomaConnect.ResourceRomaConnectInstance(),
 
+			"hcs_scm_certificate": scm.ResourceScmCertificate(),
+
+			"hcs_servicestage_application":              servicestage.ResourceApplication(),
+			"hcs_servicestage_component":                servicestage.ResourceComponent(),
+			"hcs_servicestage_component_instance":       servicestage.ResourceComponentInstance(),
+			"hcs_servicestage_environment":              servicestage.ResourceEnvironment(),
+			"hcs_servicestage_repo_token_authorization": servicestage.ResourceRepoTokenAuth(),
+
 			"hcs_sfs_access_rule": sfs.ResourceSFSAccessRuleV2(),
 			"hcs_sfs_file_system": sfs.ResourceSFSFileSystemV2(),
 
@@ -534,6 +664,9 @@ func Provider() *schema.Provider {
 			"hcs_sfs_turbo_dir":       sfs.ResourceSfsTurboDir(),
 			"hcs_sfs_turbo_dir_quota": sfs.ResourceSfsTurboDirQuota(),
 
+			"hcs_sms_server_template": sms.ResourceServerTemplate(),
+			"hcs_sms_task":            sms.ResourceMigrateTask(),
+
 			"hcs_swr_organization":           swr.ResourceSWROrganization(),
 			"hcs_swr_repository":             swr.ResourceSWRRepository(),
 			"hcs_swr_repository_sharing":     swr.ResourceSWRRepositorySharing(),
@@ -564,6 +697,10 @@ func Provider() *schema.Provider {
 			"hcs_waf_rule_precise_protection":             waf.ResourceRulePreciseProtection(),
 			"hcs_waf_rule_web_tamper_protection":          waf.ResourceWafRuleWebTamperProtectionV1(),
 
+			"hcs_workspace_desktop": workspace.ResourceDesktop(),
+			"hcs_workspace_service": workspace.ResourceService(),
+			"hcs_workspace_user":    workspace.ResourceUser(),
+
 			// Legacy
 			"hcs_as_bandwidth_policy": as.ResourceASBandWidthPolicy(),
 			"hcs_as_configuration":    as.ResourceASConfiguration(),
@@ -172,6 +172,11 @@ type Web struct {
 	//   1: (A VPC channel is used).
 	//   2: (No VPC channel is used).
 	VpcChannelStatus int `json:"vpc_channel_status,omitempty"`
+	// Number of retry attempts to request the backend service.
+	// The default value is –1, and the value ranges from –1 to 10.
+	// –1 indicates that idempotent APIs will retry once and non-idempotent APIs will not retry.
+	// POST and PATCH are non-idempotent. GET, HEAD, PUT, OPTIONS, and DELETE are idempotent.
+	RetryCount *string `json:"retry_count,omitempty"`
 }
 
 // VpcChannel is an object which will be build up a vpc channel.
@@ -225,7 +230,7 @@ type ReqParamBase struct {
 	// This parameter is valid when type is set to STRING.
 	MaxSize *int `json:"max_size,omitempty"`
 	// Indicates whether to transparently transfer the parameter. The valid values are 1 (yes) and 2 (no).
-	PassThrough string `json:"pass_through,omitempty"`
+	PassThrough int `json:"pass_through,omitempty"`
 }
 
 // PolicyMock is an object which will be build up a backend policy of the mock.
@@ -318,6 +323,11 @@ type PolicyWeb struct {
 	// Timeout, in ms, which allowed for API Gateway to request the backend service.
 	// The valid value is range from 1 to 600,000.
 	Timeout int `json:"timeout,omitempty"`
+	// Number of retry attempts to request the backend service.
+	// The default value is –1, and the value ranges from –1 to 10.
+	// –1 indicates that idempotent APIs will retry once and non-idempotent APIs will not retry.
+	// POST and PATCH are non-idempotent. GET, HEAD, PUT, OPTIONS, and DELETE are idempotent.
+	RetryCount *string `json:"retry_count,omitempty"`
 }
 
 // BackendParamBase is an object which will be build up a back-end parameter.
@@ -358,16 +368,25 @@ type APIConditionBase struct {
 	// Policy type. The valid types are as following:
 	//   param: input parameter
 	//   source: source IP address
+	//   system: gateway built-in parameter
+	//   cookie: cookie parameter
+	//   frontend_authorizer: frontend authentication parameter
 	ConditionOrigin string `json:"condition_origin" required:"true"`
 	// Condition value.
 	ConditionValue string `json:"condition_value" required:"true"`
 	// Input parameter name. This parameter is required if the policy type is param.
 	ReqParamName string `json:"req_param_name,omitempty"`
+	// Gateway built-in parameter name. This parameter is required if the policy type is system.
+	SysParamName string `json:"sys_param_name,omitempty"`
+	// Cookie parameter name. This parameter is required if the policy type is cookie.
+	CookieParamName string `json:"cookie_param_name,omitempty"`
+	// Frontend authentication parameter name. This parameter is required if the policy type is frontend_authorizer.
+	FrontendAuthorizerParamName string `json:"frontend_authorizer_param_name,omitempty"`
 	// Policy condition. The valid values are as following:
 	//   exact: exact match
 	//   enum: enumeration
 	//   pattern: regular expression
-	// This parameter is required if the policy type is param.
+	// This parameter is required if the policy type is param, system, cookie and frontend_authorizer.
 	ConditionType string `json:"condition_type,omitempty"`
 }
 
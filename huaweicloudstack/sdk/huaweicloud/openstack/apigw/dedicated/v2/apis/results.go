@@ -308,6 +308,11 @@ type PolicyWebResp struct {
 	// Timeout, in ms, which allowed for API Gateway to request the backend service.
 	// The valid value is range from 1 to 600,000.
 	Timeout int `json:"timeout,omitempty"`
+	// Number of retry attempts to request the backend service.
+	// The default value is –1, and the value ranges from –1 to 10.
+	// –1 indicates that idempotent APIs will retry once and non-idempotent APIs will not retry.
+	// POST and PATCH are non-idempotent. GET, HEAD, PUT, OPTIONS, and DELETE are idempotent.
+	RetryCount string `json:"retry_count"`
 	// Effective mode of the backend policy. The valid modes are as following:
 	//   ALL: All conditions are met.
 	//   ANY: Any condition is met.
@@ -82,5 +82,17 @@ Example of Initializing a Volume Connection
 	if err != nil {
 		panic(err)
 	}
+
+Example of Changing a Volume's Type
+
+	changeTypeOpts := volumeactions.ChangeTypeOpts{
+		NewType:         "ssd",
+		MigrationPolicy: volumeactions.MigrationPolicyOnDemand,
+	}
+
+	err = volumeactions.ChangeType(client, volume.ID, changeTypeOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 package volumeactions
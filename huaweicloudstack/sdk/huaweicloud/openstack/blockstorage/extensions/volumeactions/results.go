@@ -189,3 +189,18 @@ func (r UploadImageResult) Extract() (VolumeImage, error) {
 type ForceDeleteResult struct {
 	golangsdk.ErrResult
 }
+
+// ChangeTypeResult contains the response body and error from an ChangeType request.
+type ChangeTypeResult struct {
+	golangsdk.ErrResult
+}
+
+// SetBootableResult contains the response body and error from a SetBootable request.
+type SetBootableResult struct {
+	golangsdk.ErrResult
+}
+
+// UpdateReadonlyResult contains the response body and error from an UpdateReadonly request.
+type UpdateReadonlyResult struct {
+	golangsdk.ErrResult
+}
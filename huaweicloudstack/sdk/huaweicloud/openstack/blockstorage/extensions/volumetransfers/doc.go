@@ -0,0 +1,46 @@
+/*
+Package volumetransfers provides an interaction with volume transfers in the
+OpenStack Block Storage service. A volume transfer allows a volume to be moved
+from one project to another: the owner creates a transfer and hands the
+transfer ID and the authorization key over to the recipient, who accepts it
+within the target project.
+
+Example to Create a Volume Transfer
+
+	createOpts := volumetransfers.CreateOpts{
+		VolumeID: "uuid",
+		Name:     "my-volume-transfer",
+	}
+
+	transfer, err := volumetransfers.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(transfer)
+
+	// secret auth key is returned only once as a create response
+	authKey := transfer.AuthKey
+
+Example to Accept a Volume Transfer
+
+	acceptOpts := volumetransfers.AcceptOpts{
+		// see the create response above
+		AuthKey: authKey,
+	}
+
+	// see the transfer ID from the create response above
+	transfer, err := volumetransfers.Accept(client, transferID, acceptOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Volume Transfer
+
+	transferID := "uuid"
+	err := volumetransfers.Delete(client, transferID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package volumetransfers
@@ -0,0 +1,83 @@
+package volumetransfers
+
+import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains options for a Volume transfer.
+type CreateOpts struct {
+	// The ID of the volume to transfer.
+	VolumeID string `json:"volume_id" required:"true"`
+
+	// The name of the volume transfer
+	Name string `json:"name,omitempty"`
+}
+
+// ToCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "transfer")
+}
+
+// Create will create a volume transfer request based on the values in CreateOpts.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(transferURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// AcceptOptsBuilder allows extensions to add additional parameters to the
+// Accept request.
+type AcceptOptsBuilder interface {
+	ToAcceptMap() (map[string]interface{}, error)
+}
+
+// AcceptOpts contains options for a Volume transfer accept request.
+type AcceptOpts struct {
+	// The auth key of the volume transfer to accept.
+	AuthKey string `json:"auth_key" required:"true"`
+}
+
+// ToAcceptMap assembles a request body based on the contents of a
+// AcceptOpts.
+func (opts AcceptOpts) ToAcceptMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "accept")
+}
+
+// Accept will accept a volume transfer request based on the values in AcceptOpts.
+func Accept(client *golangsdk.ServiceClient, id string, opts AcceptOptsBuilder) (r AcceptResult) {
+	b, err := opts.ToAcceptMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(acceptURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Delete deletes a volume transfer.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Get retrieves the Transfer with the provided ID. To extract the Transfer object
+// from the response, call the Extract method on the GetResult.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
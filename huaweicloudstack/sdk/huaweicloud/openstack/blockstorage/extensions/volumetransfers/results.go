@@ -0,0 +1,72 @@
+package volumetransfers
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+)
+
+// Transfer represents a Volume Transfer record
+type Transfer struct {
+	ID        string           `json:"id"`
+	AuthKey   string           `json:"auth_key"`
+	Name      string           `json:"name"`
+	VolumeID  string           `json:"volume_id"`
+	CreatedAt time.Time        `json:"-"`
+	Links     []golangsdk.Link `json:"links"`
+}
+
+// UnmarshalJSON is our unmarshalling helper
+func (r *Transfer) UnmarshalJSON(b []byte) error {
+	type tmp Transfer
+	var s struct {
+		tmp
+		CreatedAt golangsdk.JSONRFC3339MilliNoZ `json:"created_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Transfer(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+
+	return err
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract will get the Transfer object out of the commonResult object.
+func (r commonResult) Extract() (*Transfer, error) {
+	var s Transfer
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractInto converts our response data into a transfer struct
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "transfer")
+}
+
+// CreateResult contains the response body and error from a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// AcceptResult contains the response body and error from an Accept request.
+type AcceptResult struct {
+	commonResult
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,19 @@
+package volumetransfers
+
+import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+
+func transferURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("os-volume-transfer")
+}
+
+func acceptURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("os-volume-transfer", id, "accept")
+}
+
+func deleteURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("os-volume-transfer", id)
+}
+
+func getURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("os-volume-transfer", id)
+}
@@ -0,0 +1,25 @@
+package flavors
+
+import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+
+var requestOpts = golangsdk.RequestOpts{
+	MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
+}
+
+// ListDatastores is a method to query the datastore list of the CDM service.
+func ListDatastores(c *golangsdk.ServiceClient) ([]Datastore, error) {
+	var r listDatastoresResp
+	_, err := c.Get(datastoresURL(c), &r, &golangsdk.RequestOpts{
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return r.Datastores, err
+}
+
+// List is a method to query the versions and flavors of the specified datastore.
+func List(c *golangsdk.ServiceClient, datastoreId string) ([]Version, error) {
+	var r listResp
+	_, err := c.Get(listURL(c, datastoreId), &r, &golangsdk.RequestOpts{
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return r.Versions, err
+}
@@ -0,0 +1,33 @@
+package flavors
+
+type listDatastoresResp struct {
+	Datastores []Datastore `json:"datastores"`
+}
+
+// Datastore is the structure that represents the datastore of the CDM service.
+type Datastore struct {
+	// The datastore ID.
+	ID string `json:"id"`
+	// The datastore name, e.g. cdm.
+	Name string `json:"name"`
+}
+
+type listResp struct {
+	Versions []Version `json:"versions"`
+}
+
+// Version is the structure that represents the cluster version and its available flavors.
+type Version struct {
+	// The version name.
+	Name string `json:"name"`
+	// The flavor list of the version.
+	Flavors []Flavor `json:"flavors"`
+}
+
+// Flavor is the structure that represents the flavor of the CDM cluster.
+type Flavor struct {
+	// The flavor ID.
+	ID string `json:"str_id"`
+	// The flavor name.
+	Name string `json:"name"`
+}
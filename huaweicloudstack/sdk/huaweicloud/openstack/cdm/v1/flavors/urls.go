@@ -0,0 +1,13 @@
+package flavors
+
+import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+
+// GET /v1.1/{project_id}/datastores
+func datastoresURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("datastores")
+}
+
+// GET /v1.1/{project_id}/datastores/{datastore_id}/flavors
+func listURL(c *golangsdk.ServiceClient, datastoreId string) string {
+	return c.ServiceURL("datastores", datastoreId, "flavors")
+}
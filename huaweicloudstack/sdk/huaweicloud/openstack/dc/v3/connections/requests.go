@@ -0,0 +1,51 @@
+package connections
+
+import (
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
+)
+
+// ListOpts allows to filter list data using given parameters.
+type ListOpts struct {
+	// Number of records to be queried.
+	// The valid value is range from 1 to 2000.
+	Limit int `q:"limit"`
+	// The ID of the direct connection of the last record on the previous page.
+	// If it is empty, it is the first page of the query.
+	// This parameter must be used together with limit.
+	Marker string `q:"marker"`
+	// The list of resource IDs used to filter the direct connections.
+	IDs []string `q:"id"`
+	// The list of names used to filter the direct connections.
+	Names []string `q:"name"`
+	// The list of current status used to filter the direct connections.
+	Status []string `q:"status"`
+	// The list of enterprise project IDs used to filter the direct connections.
+	EnterpriseProjectIds []string `q:"enterprise_project_id"`
+}
+
+var requestOpts = golangsdk.RequestOpts{
+	MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
+}
+
+// List is a method used to query the list of the direct connections (physical connections) using given parameters.
+func List(client *golangsdk.ServiceClient, opts ListOpts) ([]DirectConnect, error) {
+	url := rootURL(client)
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	url += query.String()
+
+	pager := pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		p := DirectConnectPage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+	pager.Headers = requestOpts.MoreHeaders
+	pages, err := pager.AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return extractDirectConnects(pages)
+}
@@ -0,0 +1,123 @@
+package connections
+
+import (
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/common/tags"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
+)
+
+// DirectConnect is the structure that represents the details of the direct connection (physical connection).
+type DirectConnect struct {
+	// The ID of the direct connection.
+	ID string `json:"id"`
+	// The name of the direct connection.
+	Name string `json:"name"`
+	// The description of the direct connection.
+	Description string `json:"description"`
+	// The type of the port used by the direct connection.
+	// The valid values are as follows:
+	// + 1G
+	// + 10G
+	// + 40G
+	// + 100G
+	PortType string `json:"port_type"`
+	// The bandwidth of the direct connection, in Mbit/s.
+	Bandwidth int `json:"bandwidth"`
+	// The access location of the direct connection.
+	Location string `json:"location"`
+	// The location of the on-premises facility at the other end of the direct connection.
+	PeerLocation string `json:"peer_location"`
+	// The ID of the device connected to the direct connection.
+	DeviceId string `json:"device_id"`
+	// The name of the interface on the device connected to the direct connection.
+	InterfaceName string `json:"interface_name"`
+	// The ID of the redundant direct connection.
+	RedundantId string `json:"redundant_id"`
+	// The carrier who provides the leased line.
+	Provider string `json:"provider"`
+	// The status of the carrier's leased line.
+	ProviderStatus string `json:"provider_status"`
+	// The type of the direct connection.
+	Type string `json:"type"`
+	// The ID of the operations connection on which the hosted connection is created.
+	HostingId string `json:"hosting_id"`
+	// The VLAN allocated to the hosted connection.
+	Vlan int `json:"vlan"`
+	// The billing mode of the direct connection.
+	ChargeMode string `json:"charge_mode"`
+	// The time when the direct connection was applied for.
+	ApplyTime string `json:"apply_time"`
+	// The creation time of the direct connection.
+	CreateTime string `json:"create_time"`
+	// The current status of the direct connection.
+	// The valid values are as follows:
+	// + ACTIVE
+	// + DOWN
+	// + BUILD
+	// + ERROR
+	// + PENDING_DELETE
+	// + DELETED
+	// + APPLY
+	// + DENY
+	// + PENDING_PAY
+	// + PAID
+	// + ORDERING
+	// + ACCEPT
+	// + REJECTED
+	Status string `json:"status"`
+	// The administrative status of the direct connection.
+	AdminStateUp bool `json:"admin_state_up"`
+	// The ID of the link aggregation group (LAG) to which the direct connection belongs.
+	LagId string `json:"lag_id"`
+	// The ID of the Intelligent EdgeSite (IES) associated with the direct connection.
+	IesId string `json:"ies_id"`
+	// The enterprise project ID to which the direct connection belongs.
+	EnterpriseProjectId string `json:"enterprise_project_id"`
+	// The key/value pairs to associate with the direct connection.
+	Tags []tags.ResourceTag `json:"tags"`
+}
+
+// listResp is the structure that represents the API response of the 'List' method.
+type listResp struct {
+	// The list of the direct connections.
+	DirectConnects []DirectConnect `json:"direct_connects"`
+	// The request ID.
+	RequestId string `json:"request_id"`
+	// The page information.
+	PageInfo pageInfo `json:"page_info"`
+}
+
+// pageInfo is the structure that represents the page information.
+type pageInfo struct {
+	// The next marker information.
+	NextMarker string `json:"next_marker"`
+	// The number of the direct connections in current page.
+	CurrentCount int `json:"current_count"`
+}
+
+// DirectConnectPage represents the response pages of the List method.
+type DirectConnectPage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty returns true if a ListResult no direct connection.
+func (r DirectConnectPage) IsEmpty() (bool, error) {
+	resp, err := extractDirectConnects(r)
+	return len(resp) == 0, err
+}
+
+// LastMarker returns the last marker index in a ListResult.
+func (r DirectConnectPage) LastMarker() (string, error) {
+	var s listResp
+	err := r.Result.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.PageInfo.NextMarker, nil
+}
+
+// extractDirectConnects is a method which to extract the response to a direct connection list.
+func extractDirectConnects(r pagination.Page) ([]DirectConnect, error) {
+	var s listResp
+	err := r.(DirectConnectPage).Result.ExtractInto(&s)
+	return s.DirectConnects, err
+}
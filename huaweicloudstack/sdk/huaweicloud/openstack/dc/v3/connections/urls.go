@@ -0,0 +1,7 @@
+package connections
+
+import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL("dcaas/direct-connects")
+}
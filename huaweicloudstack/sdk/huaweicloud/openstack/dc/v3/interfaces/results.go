@@ -136,11 +136,13 @@ type VifPeer struct {
 	RemoteEpGroup []string `json:"remote_ep_group"`
 	// The CIDR list of subnets in service side.
 	ServiceEpGroup string `json:"service_ep_group"`
+	// The route mode of the VIF peer.
+	RouteMode string `json:"route_mode"`
 	// Attributed Device ID.
 	DeviceId string `json:"device_id"`
-	// Attributed Device ID.
+	// The maximum number of routes learned by BGP.
 	BgpRouteLimit int `json:"bgp_route_limit"`
-	// Attributed Device ID.
+	// The BGP protocol status of the VIF peer.
 	BgpStatus string `json:"bgp_status"`
 	// The virtual interface ID corresponding to the VIF peer.
 	VifId string `json:"vif_id"`
@@ -0,0 +1,129 @@
+package dumptasks
+
+import (
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+)
+
+const (
+	DestinationTypeObs = "OBS"
+	DestinationTypeDws = "DWS"
+
+	StateError    = "ERROR"
+	StateStarting = "STARTING"
+	StateRunning  = "RUNNING"
+	StateAbnormal = "ABNORMAL"
+)
+
+type CreateOpts struct {
+	// Dump destination. Possible values: OBS and DWS.
+	DestinationType string `json:"destination_type" required:"true"`
+	// Parameter list of the OBS to which data in the DIS stream will be dumped.
+	ObsDestinationDescriptor *ObsDestinationDescriptorOpts `json:"obs_destination_descriptor,omitempty"`
+	// Parameter list of the DWS to which data in the DIS stream will be dumped.
+	DwsDestinationDescriptor *DwsDestinationDescriptorOpts `json:"dws_destination_descriptor,omitempty"`
+}
+
+type ObsDestinationDescriptorOpts struct {
+	// Name of the dump task. The task name consists of letters, digits, hyphens (-), and underscores (_).
+	// It must be a string of 1 to 64 characters.
+	TaskName string `json:"task_name" required:"true"`
+	// Name of the agency created on IAM. DIS uses an agency to access your specified resources.
+	AgencyName string `json:"agency_name" required:"true"`
+	// User-defined interval (in seconds) at which data is imported from the current DIS stream into OBS.
+	// Value range: 30~900.
+	DeliverTimeInterval int `json:"deliver_time_interval" required:"true"`
+	// Offset. LATEST: Maximum offset, TRIM_HORIZON: Minimum offset.
+	ConsumerStrategy string `json:"consumer_strategy,omitempty"`
+	// Directory to store files that will be dumped to OBS.
+	FilePrefix string `json:"file_prefix,omitempty"`
+	// Directory structure of the object file written into OBS, e.g. yyyy, yyyy/MM, yyyy/MM/dd.
+	PartitionFormat string `json:"partition_format,omitempty"`
+	// Name of the OBS bucket used to store data from the DIS stream.
+	ObsBucketPath string `json:"obs_bucket_path" required:"true"`
+	// Dump file format. Possible values: text (default), parquet and carbon.
+	DestinationFileType string `json:"destination_file_type,omitempty"`
+	// Delimiter for the dump file, which is used to separate the user data that is written into the dump file.
+	RecordDelimiter string `json:"record_delimiter,omitempty"`
+}
+
+type DwsDestinationDescriptorOpts struct {
+	// Name of the dump task.
+	TaskName string `json:"task_name" required:"true"`
+	// Name of the agency created on IAM.
+	AgencyName string `json:"agency_name" required:"true"`
+	// User-defined interval (in seconds) at which data is imported from the current DIS stream into DWS.
+	DeliverTimeInterval int `json:"deliver_time_interval" required:"true"`
+	// Offset. LATEST: Maximum offset, TRIM_HORIZON: Minimum offset.
+	ConsumerStrategy string `json:"consumer_strategy,omitempty"`
+	// Name of the DWS cluster that stores the data in the stream.
+	DwsClusterName string `json:"dws_cluster_name" required:"true"`
+	// ID of the DWS cluster to which will be dumped.
+	DwsClusterId string `json:"dws_cluster_id" required:"true"`
+	// Name of the DWS database that stores the data in the stream.
+	DwsDatabaseName string `json:"dws_database_name" required:"true"`
+	// Schema of the DWS database.
+	DwsSchema string `json:"dws_schema" required:"true"`
+	// Name of the DWS table that stores the data in the stream.
+	DwsTableName string `json:"dws_table_name" required:"true"`
+	// Delimiter used to separate the columns in the DWS tables.
+	DwsDelimiter string `json:"dws_delimiter" required:"true"`
+	// Username of the DWS database.
+	UserName string `json:"user_name" required:"true"`
+	// Password of the DWS database.
+	UserPassword string `json:"user_password" required:"true"`
+	// Key created in Key Management Service (KMS) and used to encrypt the password of the DWS database.
+	KmsUserKeyName string `json:"kms_user_key_name" required:"true"`
+	// ID of the key created in KMS and used to encrypt the password of the DWS database.
+	KmsUserKeyId string `json:"kms_user_key_id" required:"true"`
+	// Name of the OBS bucket used to temporarily store data in the DIS stream.
+	ObsBucketPath string `json:"obs_bucket_path" required:"true"`
+	// User-defined directory that stores files that will be dumped to OBS.
+	FilePrefix string `json:"file_prefix,omitempty"`
+	// Duration when you can constantly retry dumping data to DWS after the dump fails.
+	// Value range: 0~7200, in seconds.
+	RetryDuration *int `json:"retry_duration,omitempty"`
+	// Column to be dumped to the DWS table. If no column is specified, all columns are dumped by default.
+	DwsTableColumns string `json:"dws_table_columns,omitempty"`
+}
+
+// Create is a method to create a dump task for the specified DIS stream.
+func Create(c *golangsdk.ServiceClient, streamName string, opts CreateOpts) error {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Post(rootURL(c, streamName), b, nil, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{
+			"Content-Type": "application/json",
+			"region":       c.AKSKAuthOptions.Region,
+		},
+	})
+	return err
+}
+
+// Get is a method to query the details of the dump task by its name.
+func Get(c *golangsdk.ServiceClient, streamName, taskName string) (*DumpTask, error) {
+	var rst DumpTask
+	_, err := c.Get(resourceURL(c, streamName, taskName), &rst, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{
+			"Content-Type": "application/json",
+			"region":       c.AKSKAuthOptions.Region,
+		},
+	})
+	if err == nil {
+		return &rst, nil
+	}
+	return nil, err
+}
+
+// Delete is a method to delete the dump task by its name.
+func Delete(c *golangsdk.ServiceClient, streamName, taskName string) error {
+	_, err := c.Delete(resourceURL(c, streamName, taskName), &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{
+			"Content-Type": "application/json",
+			"region":       c.AKSKAuthOptions.Region,
+		},
+	})
+	return err
+}
@@ -0,0 +1,67 @@
+package dumptasks
+
+type DumpTask struct {
+	// Name of the stream to which the dump task belongs.
+	StreamName string `json:"stream_name"`
+	// Name of the dump task.
+	TaskName string `json:"task_name"`
+	// ID of the dump task.
+	TaskId string `json:"task_id"`
+	// Dump task status. Possible values: ERROR, STARTING, PAUSED, RUNNING, DELETE and ABNORMAL.
+	State string `json:"state"`
+	// Dump destination. Possible values: OBS and DWS.
+	DestinationType string `json:"destination_type"`
+	// Time when the dump task is created.
+	CreateTime int64 `json:"create_time"`
+	// Latest dump time of the dump task.
+	LastTransferTimestamp int64 `json:"last_transfer_timestamp"`
+	// List of partition dump details.
+	Partitions []PartitionResult `json:"partitions"`
+	// Parameter list of OBS to which data in the DIS stream will be dumped.
+	ObsDestinationDescription ObsDestinationDescriptor `json:"obs_destination_description"`
+	// Parameter list of the DWS to which data in the DIS stream will be dumped.
+	DwsDestinationDescription DwsDestinationDescriptor `json:"dws_destination_description"`
+}
+
+type PartitionResult struct {
+	// Current status of the partition. Possible values: CREATING, ACTIVE, DELETED and EXPIRED.
+	Status string `json:"status"`
+	// Unique identifier of the partition.
+	PartitionId string `json:"partition_id"`
+	// Possible value range of the hash key used by the partition.
+	HashRange string `json:"hash_range"`
+	// Sequence number range of the partition.
+	SequenceNumberRange string `json:"sequence_number_range"`
+}
+
+type ObsDestinationDescriptor struct {
+	TaskName            string `json:"task_name"`
+	AgencyName          string `json:"agency_name"`
+	DeliverTimeInterval int    `json:"deliver_time_interval"`
+	ConsumerStrategy    string `json:"consumer_strategy"`
+	FilePrefix          string `json:"file_prefix"`
+	PartitionFormat     string `json:"partition_format"`
+	ObsBucketPath       string `json:"obs_bucket_path"`
+	DestinationFileType string `json:"destination_file_type"`
+	RecordDelimiter     string `json:"record_delimiter"`
+}
+
+type DwsDestinationDescriptor struct {
+	TaskName            string `json:"task_name"`
+	AgencyName          string `json:"agency_name"`
+	DeliverTimeInterval int    `json:"deliver_time_interval"`
+	ConsumerStrategy    string `json:"consumer_strategy"`
+	DwsClusterName      string `json:"dws_cluster_name"`
+	DwsClusterId        string `json:"dws_cluster_id"`
+	DwsDatabaseName     string `json:"dws_database_name"`
+	DwsSchema           string `json:"dws_schema"`
+	DwsTableName        string `json:"dws_table_name"`
+	DwsDelimiter        string `json:"dws_delimiter"`
+	UserName            string `json:"user_name"`
+	KmsUserKeyName      string `json:"kms_user_key_name"`
+	KmsUserKeyId        string `json:"kms_user_key_id"`
+	ObsBucketPath       string `json:"obs_bucket_path"`
+	FilePrefix          string `json:"file_prefix"`
+	RetryDuration       int    `json:"retry_duration"`
+	DwsTableColumns     string `json:"dws_table_columns"`
+}
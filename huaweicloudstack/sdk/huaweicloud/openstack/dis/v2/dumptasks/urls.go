@@ -0,0 +1,19 @@
+package dumptasks
+
+import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+
+const (
+	streamPath   = "streams"
+	resourcePath = "transfer-tasks"
+)
+
+// rootURL POST /v2/{project_id}/streams/{stream_name}/transfer-tasks
+func rootURL(c *golangsdk.ServiceClient, streamName string) string {
+	return c.ServiceURL(streamPath, streamName, resourcePath)
+}
+
+// resourceURL GET    /v2/{project_id}/streams/{stream_name}/transfer-tasks/{task_name}
+// resourceURL DELETE /v2/{project_id}/streams/{stream_name}/transfer-tasks/{task_name}
+func resourceURL(c *golangsdk.ServiceClient, streamName, taskName string) string {
+	return c.ServiceURL(streamPath, streamName, resourcePath, taskName)
+}
@@ -85,6 +85,25 @@ type StartInfo struct {
 	StartTime string `json:"start_time,omitempty"`
 }
 
+type BatchPauseJobReq struct {
+	Jobs []PauseInfo `json:"jobs" required:"true"`
+}
+
+type PauseInfo struct {
+	JobId string `json:"job_id" required:"true"`
+	// target: pause the replay of the destination database only
+	// all: pause both the capture of the source database and the replay of the destination database
+	PauseMode string `json:"pause_mode" required:"true"`
+}
+
+type BatchRestartJobReq struct {
+	Jobs []RestartInfo `json:"jobs" required:"true"`
+}
+
+type RestartInfo struct {
+	JobId string `json:"job_id" required:"true"`
+}
+
 type TestConnectionsReq struct {
 	Jobs []TestEndPoint `json:"jobs" required:"true"`
 }
@@ -362,3 +381,29 @@ func Status(c *golangsdk.ServiceClient, opts QueryJobReq) (*StatusResp, error) {
 
 	return &rst, err
 }
+
+func Pause(c *golangsdk.ServiceClient, opts BatchPauseJobReq) (*ActionResp, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var rst ActionResp
+	_, err = c.Put(pauseURL(c), b, &rst, &golangsdk.RequestOpts{
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return &rst, err
+}
+
+func Restart(c *golangsdk.ServiceClient, opts BatchRestartJobReq) (*ActionResp, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var rst ActionResp
+	_, err = c.Post(restartURL(c), b, &rst, &golangsdk.RequestOpts{
+		MoreHeaders: RequestOpts.MoreHeaders,
+	})
+	return &rst, err
+}
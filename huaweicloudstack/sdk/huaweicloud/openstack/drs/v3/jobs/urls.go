@@ -56,3 +56,13 @@ func batchCheckResultsURL(c *golangsdk.ServiceClient) string {
 func listURL(c *golangsdk.ServiceClient) string {
 	return c.ServiceURL("jobs")
 }
+
+// PUT /v3/{project_id}/jobs/batch-pause-task
+func pauseURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("jobs", "batch-pause-task")
+}
+
+// POST /v3/{project_id}/jobs/batch-restart-task
+func restartURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("jobs", "batch-restart-task")
+}
@@ -0,0 +1,11 @@
+package aliases
+
+import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+
+func rootURL(client *golangsdk.ServiceClient, functionUrn string) string {
+	return client.ServiceURL("fgs/functions", functionUrn, "aliases")
+}
+
+func resourceURL(client *golangsdk.ServiceClient, functionUrn, aliasName string) string {
+	return client.ServiceURL("fgs/functions", functionUrn, "aliases", aliasName)
+}
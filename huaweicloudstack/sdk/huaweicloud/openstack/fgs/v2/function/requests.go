@@ -9,18 +9,18 @@ import (
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
 )
 
-//Create function
+// Create function
 type CreateOptsBuilder interface {
 	ToCreateFunctionMap() (map[string]interface{}, error)
 }
 
-//funcCode struct
+// funcCode struct
 type FunctionCodeOpts struct {
 	File string `json:"file" required:"true"`
 	Link string `json:"-"`
 }
 
-//function struct
+// function struct
 type CreateOpts struct {
 	FuncName            string            `json:"func_name" required:"true"`
 	MemorySize          int               `json:"memory_size" required:"true"`
@@ -40,6 +40,7 @@ type CreateOpts struct {
 	Type                string            `json:"type,omitempty"`
 	UserData            string            `json:"user_data,omitempty"`
 	Xrole               string            `json:"xrole,omitempty"`
+	LogConfig           *FuncLogConfig    `json:"log_config,omitempty"`
 }
 
 type CustomImage struct {
@@ -51,7 +52,7 @@ func (opts CreateOpts) ToCreateFunctionMap() (map[string]interface{}, error) {
 	return golangsdk.BuildRequestBody(opts, "")
 }
 
-//create funtion
+// create funtion
 func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
 	f, err := opts.ToCreateFunctionMap()
 	if err != nil {
@@ -62,10 +63,11 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 	return
 }
 
-//functions list struct
+// functions list struct
 type ListOpts struct {
-	Marker   string `q:"marker"`
-	MaxItems string `q:"maxitems"`
+	Marker      string `q:"marker"`
+	MaxItems    string `q:"maxitems"`
+	PackageName string `q:"package_name"`
 }
 
 func (opts ListOpts) ToMetricsListQuery() (string, error) {
@@ -77,7 +79,7 @@ type ListOptsBuilder interface {
 	ToMetricsListQuery() (string, error)
 }
 
-//functions list
+// functions list
 func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 	url := listURL(client)
 	if opts != nil {
@@ -92,19 +94,19 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Page
 	})
 }
 
-//Querying the Metadata Information of a Function
+// Querying the Metadata Information of a Function
 func GetMetadata(c *golangsdk.ServiceClient, functionUrn string) (r GetResult) {
 	_, r.Err = c.Get(getMetadataURL(c, functionUrn), &r.Body, nil)
 	return
 }
 
-//Querying the Code of a Function
+// Querying the Code of a Function
 func GetCode(c *golangsdk.ServiceClient, functionUrn string) (r GetResult) {
 	_, r.Err = c.Get(getCodeURL(c, functionUrn), &r.Body, nil)
 	return
 }
 
-//Deleting a Function or Function Version
+// Deleting a Function or Function Version
 func Delete(c *golangsdk.ServiceClient, functionUrn string) (r DeleteResult) {
 	_, r.Err = c.Delete(deleteURL(c, functionUrn), nil)
 	return
@@ -114,7 +116,7 @@ type UpdateOptsBuilder interface {
 	ToUpdateMap() (map[string]interface{}, error)
 }
 
-//Function struct for update
+// Function struct for update
 type UpdateCodeOpts struct {
 	CodeType     string           `json:"code_type" required:"true"`
 	CodeUrl      string           `json:"code_url,omitempty"`
@@ -127,7 +129,7 @@ func (opts UpdateCodeOpts) ToUpdateMap() (map[string]interface{}, error) {
 	return golangsdk.BuildRequestBody(opts, "")
 }
 
-//Modifying the Code of a Function
+// Modifying the Code of a Function
 func UpdateCode(c *golangsdk.ServiceClient, functionUrn string, opts UpdateOptsBuilder) (r UpdateResult) {
 	b, err := opts.ToUpdateMap()
 	if err != nil {
@@ -138,7 +140,7 @@ func UpdateCode(c *golangsdk.ServiceClient, functionUrn string, opts UpdateOptsB
 	return
 }
 
-//Metadata struct for update
+// Metadata struct for update
 type UpdateMetadataOpts struct {
 	Handler            string       `json:"handler" required:"true"`
 	MemorySize         int          `json:"memory_size" required:"true"`
@@ -156,13 +158,67 @@ type UpdateMetadataOpts struct {
 	InitializerHandler string       `json:"initializer_handler,omitempty"`
 	InitializerTimeout int          `json:"initializer_timeout,omitempty"`
 	CustomImage        *CustomImage `json:"custom_image,omitempty"`
+	// GPU memory.
+	// Range: 1024 to 16,384, and the value is a multiple of 1024.
+	GPUMemory int `json:"gpu_memory,omitempty"`
+	// Function policy configuration.
+	StrategyConfig *StrategyConfig `json:"strategy_config,omitempty"`
+	// Extended configuration.
+	ExtendConfig string `json:"extend_config,omitempty"`
+	// Ephemeral storage size, the maximum value is 10 GB. Defaults to 512 MB.
+	EphemeralStorage int `json:"ephemeral_storage,omitempty"`
+	// Enterprise project ID.
+	EnterpriseProjectID string `json:"enterprise_project_id,omitempty"`
+	// Function log configuration.
+	LogConfig *FuncLogConfig `json:"log_config,omitempty"`
+	// Network configuration.
+	NetworkController NetworkControlConfig `json:"network_controller,omitempty"`
+	// Whether stateful functions are supported.
+	IsStatefulFunction bool `json:"is_stateful_function,omitempty"`
+	// Whether to enable dynamic memory allocation.
+	EnableDynamicMemory bool `json:"enable_dynamic_memory,omitempty"`
+	// Whether to allow authentication information in the request header.
+	EnableAuthInHeader bool `json:"enable_auth_in_header,omitempty"`
+	// Private domain name.
+	DomainNames string `json:"domain_names,omitempty"`
+	// Restore Hook entry point for snapshot-based cold start in the format "xx.xx".
+	// The period (.) must be included.
+	RestoreHookHandler string `json:"restore_hook_handler,omitempty"`
+	// Restore Hook timeout of snapshot-based cold start.
+	// Range: 1s to 300s.
+	RestoreHookTimeout int `json:"restore_hook_timeout,omitempty"`
+}
+
+type FuncLogConfig struct {
+	// Name of the log group bound to the function.
+	GroupName string `json:"group_name,omitempty"`
+	// ID of the log group bound to the function.
+	GroupId string `json:"group_id,omitempty"`
+	// Name of the log stream bound to the function.
+	StreamName string `json:"stream_name,omitempty"`
+	// ID of the log stream bound to the function.
+	StreamId string `json:"stream_id,omitempty"`
+}
+
+type NetworkControlConfig struct {
+	// Disable public access.
+	DisablePublicNetwork bool `json:"disable_public_network,omitempty"`
+	// VPC access restriction.
+	TriggerAccessVpcs []VpcConfig `json:"trigger_access_vpcs,omitempty"`
+}
+
+type VpcConfig struct {
+	// VPC name.
+	VpcName string `json:"vpc_name,omitempty"`
+	// VPC ID.
+	VpcId string `json:"vpc_id,omitempty"`
 }
 
 func (opts UpdateMetadataOpts) ToUpdateMap() (map[string]interface{}, error) {
 	return golangsdk.BuildRequestBody(opts, "")
 }
 
-//Modifying the Metadata Information of a Function
+// Modifying the Metadata Information of a Function
 func UpdateMetadata(c *golangsdk.ServiceClient, functionUrn string, opts UpdateOptsBuilder) (r UpdateResult) {
 	b, err := opts.ToUpdateMap()
 	if err != nil {
@@ -173,7 +229,7 @@ func UpdateMetadata(c *golangsdk.ServiceClient, functionUrn string, opts UpdateO
 	return
 }
 
-//verstion struct
+// verstion struct
 type CreateVersionOpts struct {
 	Digest      string `json:"digest,omitempty"`
 	Description string `json:"description,omitempty"`
@@ -184,7 +240,7 @@ func (opts CreateVersionOpts) ToCreateFunctionMap() (map[string]interface{}, err
 	return golangsdk.BuildRequestBody(opts, "")
 }
 
-//Publishing a Function Version
+// Publishing a Function Version
 func CreateVersion(c *golangsdk.ServiceClient, opts CreateOptsBuilder, functionUrn string) (r CreateResult) {
 	b, err := opts.ToCreateFunctionMap()
 	if err != nil {
@@ -195,7 +251,7 @@ func CreateVersion(c *golangsdk.ServiceClient, opts CreateOptsBuilder, functionU
 	return
 }
 
-//Querying the Alias Information of a Function Version
+// Querying the Alias Information of a Function Version
 func ListVersions(c *golangsdk.ServiceClient, opts ListOptsBuilder, functionUrn string) pagination.Pager {
 	url := listVersionURL(c, functionUrn)
 	if opts != nil {
@@ -210,7 +266,7 @@ func ListVersions(c *golangsdk.ServiceClient, opts ListOptsBuilder, functionUrn
 	})
 }
 
-//Alias struct
+// Alias struct
 type CreateAliasOpts struct {
 	Name    string `json:"name" required:"true"`
 	Version string `json:"version" required:"true"`
@@ -220,7 +276,7 @@ func (opts CreateAliasOpts) ToCreateFunctionMap() (map[string]interface{}, error
 	return golangsdk.BuildRequestBody(opts, "")
 }
 
-//Creating an Alias for a Function Version
+// Creating an Alias for a Function Version
 func CreateAlias(c *golangsdk.ServiceClient, opts CreateOptsBuilder, functionUrn string) (r CreateResult) {
 	b, err := opts.ToCreateFunctionMap()
 	if err != nil {
@@ -231,7 +287,7 @@ func CreateAlias(c *golangsdk.ServiceClient, opts CreateOptsBuilder, functionUrn
 	return
 }
 
-//Alias struct for update
+// Alias struct for update
 type UpdateAliasOpts struct {
 	Version     string `json:"version" required:"true"`
 	Description string `json:"description,omitempty"`
@@ -241,7 +297,7 @@ func (opts UpdateAliasOpts) ToUpdateMap() (map[string]interface{}, error) {
 	return golangsdk.BuildRequestBody(opts, "")
 }
 
-//Modifying the Alias Information of a Function Version
+// Modifying the Alias Information of a Function Version
 func UpdateAlias(c *golangsdk.ServiceClient, functionUrn, aliasName string, opts UpdateOptsBuilder) (r UpdateResult) {
 	b, err := opts.ToUpdateMap()
 	if err != nil {
@@ -252,26 +308,26 @@ func UpdateAlias(c *golangsdk.ServiceClient, functionUrn, aliasName string, opts
 	return
 }
 
-//Deleting an Alias of a Function Version
+// Deleting an Alias of a Function Version
 func DeleteAlias(c *golangsdk.ServiceClient, functionUrn, aliasName string) (r DeleteResult) {
 	_, r.Err = c.Delete(deleteAliasURL(c, functionUrn, aliasName), &golangsdk.RequestOpts{OkCodes: []int{204}})
 	return
 }
 
-//Querying the Alias Information of a Function Version
+// Querying the Alias Information of a Function Version
 func GetAlias(c *golangsdk.ServiceClient, functionUrn, aliasName string) (r GetResult) {
 	_, r.Err = c.Get(getAliasURL(c, functionUrn, aliasName), &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
 	return
 }
 
-//Querying the Aliases of a Function's All Versions
+// Querying the Aliases of a Function's All Versions
 func ListAlias(c *golangsdk.ServiceClient, functionUrn string) pagination.Pager {
 	return pagination.NewPager(c, listAliasURL(c, functionUrn), func(r pagination.PageResult) pagination.Page {
 		return FunctionPage{pagination.SinglePageBase(r)}
 	})
 }
 
-//Executing a Function Synchronously
+// Executing a Function Synchronously
 func Invoke(c *golangsdk.ServiceClient, m map[string]interface{}, functionUrn string) (r CreateResult) {
 	var resp *http.Response
 	resp, r.Err = c.Post(invokeURL(c, functionUrn), m, nil, &golangsdk.RequestOpts{
@@ -286,7 +342,7 @@ func Invoke(c *golangsdk.ServiceClient, m map[string]interface{}, functionUrn st
 	return
 }
 
-//Executing a Function Asynchronously
+// Executing a Function Asynchronously
 func AsyncInvoke(c *golangsdk.ServiceClient, m map[string]interface{}, functionUrn string) (r CreateResult) {
 	_, r.Err = c.Post(asyncInvokeURL(c, functionUrn), m, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{202}})
 	return
@@ -0,0 +1,7 @@
+package versions
+
+import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+
+func rootURL(client *golangsdk.ServiceClient, functionUrn string) string {
+	return client.ServiceURL("fgs/functions", functionUrn, "versions")
+}
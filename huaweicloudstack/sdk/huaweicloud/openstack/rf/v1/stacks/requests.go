@@ -178,3 +178,29 @@ func Delete(client *golangsdk.ServiceClient, stackName string, opts DeleteOpts)
 	})
 	return err
 }
+
+// ListOutputsOpts allows to filter the output list using given parameters.
+type ListOutputsOpts struct {
+	// The unique ID of the resource stack.
+	StackId string `q:"stack_id"`
+}
+
+// ListAllOutputs is a method to query all outputs of the latest deployment for a specified stack.
+func ListAllOutputs(client *golangsdk.ServiceClient, stackName string, opts ListOutputsOpts) ([]StackOutput, error) {
+	url := outputURL(client, stackName)
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	url += query.String()
+
+	var r listOutputsResp
+	_, err = client.Get(url, &r, &golangsdk.RequestOpts{
+		MoreHeaders: client.MoreHeaders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Outputs, nil
+}
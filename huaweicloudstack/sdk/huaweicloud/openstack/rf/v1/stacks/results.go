@@ -126,3 +126,24 @@ type StackEvent struct {
 	// The time spent changing the resource, in seconds.
 	EventSeconds string `json:"event_seconds"`
 }
+
+// listOutputsResp is the structure that represents the API response of the 'ListAllOutputs' method, which contains
+// the list of the stack outputs.
+type listOutputsResp struct {
+	// The list of the stack outputs.
+	Outputs []StackOutput `json:"outputs"`
+}
+
+// StackOutput is the structure that represents the details of the output defined in the template.
+type StackOutput struct {
+	// The output name.
+	Name string `json:"name"`
+	// The output description.
+	Description string `json:"description"`
+	// The type of the output value.
+	Type string `json:"type"`
+	// The output value, which is a JSON string.
+	Value string `json:"value"`
+	// Whether the output value is sensitive.
+	Sensitive bool `json:"sensitive"`
+}
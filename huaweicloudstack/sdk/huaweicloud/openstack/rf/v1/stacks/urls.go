@@ -17,3 +17,7 @@ func deploymentURL(client *golangsdk.ServiceClient, stackName string) string {
 func eventURL(client *golangsdk.ServiceClient, stackName string) string {
 	return client.ServiceURL("stacks", stackName, "events")
 }
+
+func outputURL(client *golangsdk.ServiceClient, stackName string) string {
+	return client.ServiceURL("stacks", stackName, "outputs")
+}
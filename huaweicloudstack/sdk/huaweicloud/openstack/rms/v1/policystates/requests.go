@@ -0,0 +1,67 @@
+package policystates
+
+import (
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
+)
+
+// ListOpts is the structure required by the List methods to query the policy evaluation results.
+type ListOpts struct {
+	// The compliance state of the resources.
+	// The valid values are as follows:
+	// + Compliant
+	// + NonCompliant
+	ComplianceState string `q:"compliance_state"`
+	// The ID of the evaluated resource.
+	ResourceId string `q:"resource_id"`
+	// The name of the evaluated resource.
+	ResourceName string `q:"resource_name"`
+	// The maximum number of records returned in each page.
+	Limit int `q:"limit"`
+	// The pagination marker.
+	Marker string `q:"marker"`
+}
+
+var requestOpts = golangsdk.RequestOpts{
+	MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
+}
+
+// List is a method to query all policy evaluation results of the account using given parameters.
+func List(client *golangsdk.ServiceClient, domainId string, opts ListOpts) ([]PolicyState, error) {
+	return listPolicyStates(client, rootURL(client, domainId), opts)
+}
+
+// ListByAssignment is a method to query the policy evaluation results of the specified policy assignment.
+func ListByAssignment(client *golangsdk.ServiceClient, domainId, assignmentId string,
+	opts ListOpts) ([]PolicyState, error) {
+	return listPolicyStates(client, assignmentURL(client, domainId, assignmentId), opts)
+}
+
+// ListByResource is a method to query the policy evaluation results of the specified resource.
+func ListByResource(client *golangsdk.ServiceClient, domainId, resourceId string,
+	opts ListOpts) ([]PolicyState, error) {
+	// The resource ID is the path parameter, the query parameter is not supported.
+	opts.ResourceId = ""
+	return listPolicyStates(client, resourceURL(client, domainId, resourceId), opts)
+}
+
+func listPolicyStates(client *golangsdk.ServiceClient, url string, opts ListOpts) ([]PolicyState, error) {
+	query, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+	url += query.String()
+
+	pager := pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		p := PolicyStatePage{pagination.MarkerPageBase{PageResult: r}}
+		p.MarkerPageBase.Owner = p
+		return p
+	})
+	pager.Headers = requestOpts.MoreHeaders
+
+	pages, err := pager.AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractPolicyStates(pages)
+}
@@ -0,0 +1,76 @@
+package policystates
+
+import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/pagination"
+
+// PolicyState is the structure that represents the evaluation result of a resource under a policy assignment.
+type PolicyState struct {
+	// The ID of the account to which the resource belongs.
+	DomainId string `json:"domain_id"`
+	// The ID of the region to which the resource belongs.
+	RegionId string `json:"region_id"`
+	// The ID of the evaluated resource.
+	ResourceId string `json:"resource_id"`
+	// The name of the evaluated resource.
+	ResourceName string `json:"resource_name"`
+	// The cloud service name of the evaluated resource.
+	ResourceProvider string `json:"resource_provider"`
+	// The type of the evaluated resource.
+	ResourceType string `json:"resource_type"`
+	// The trigger type of the evaluation.
+	// + resource
+	// + period
+	TriggerType string `json:"trigger_type"`
+	// The compliance state of the evaluated resource.
+	ComplianceState string `json:"compliance_state"`
+	// The ID of the policy assignment.
+	PolicyAssignmentId string `json:"policy_assignment_id"`
+	// The name of the policy assignment.
+	PolicyAssignmentName string `json:"policy_assignment_name"`
+	// The ID of the policy definition.
+	PolicyDefinitionId string `json:"policy_definition_id"`
+	// The evaluation time, in milliseconds.
+	EvaluationTime string `json:"evaluation_time"`
+}
+
+// listResp is the structure that represents the page details of the policy evaluation results.
+type listResp struct {
+	PolicyStates []PolicyState `json:"value"`
+	// The information of the current query page.
+	PageInfo pageInfo `json:"page_info"`
+}
+
+// pageInfo is the structure that represents the information of the policy state page.
+type pageInfo struct {
+	// The policy state count of the current page.
+	CurrentCount int `json:"current_count"`
+	// The next marker of the policy state page.
+	NextMarker string `json:"next_marker"`
+}
+
+// PolicyStatePage represents the response pages of the List methods.
+type PolicyStatePage struct {
+	pagination.MarkerPageBase
+}
+
+// IsEmpty returns true if a query result no policy state.
+func (r PolicyStatePage) IsEmpty() (bool, error) {
+	resp, err := ExtractPolicyStates(r)
+	return len(resp) == 0, err
+}
+
+// LastMarker returns the marker of the next page in a query result.
+func (r PolicyStatePage) LastMarker() (string, error) {
+	var s listResp
+	err := r.Result.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.PageInfo.NextMarker, nil
+}
+
+// ExtractPolicyStates is a method which to extract the response to a policy state list.
+func ExtractPolicyStates(r pagination.Page) ([]PolicyState, error) {
+	var s listResp
+	err := r.(PolicyStatePage).Result.ExtractInto(&s)
+	return s.PolicyStates, err
+}
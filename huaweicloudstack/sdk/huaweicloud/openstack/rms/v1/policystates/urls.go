@@ -0,0 +1,15 @@
+package policystates
+
+import "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+
+func rootURL(client *golangsdk.ServiceClient, domainId string) string {
+	return client.ServiceURL("resource-manager/domains", domainId, "policy-states")
+}
+
+func assignmentURL(client *golangsdk.ServiceClient, domainId, assignmentId string) string {
+	return client.ServiceURL("resource-manager/domains", domainId, "policy-assignments", assignmentId, "policy-states")
+}
+
+func resourceURL(client *golangsdk.ServiceClient, domainId, resourceId string) string {
+	return client.ServiceURL("resource-manager/domains", domainId, "resources", resourceId, "policy-states")
+}
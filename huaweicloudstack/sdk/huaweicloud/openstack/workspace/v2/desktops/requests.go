@@ -37,6 +37,8 @@ type CreateOpts struct {
 	SecurityGroups []SecurityGroup `json:"security_groups,omitempty"`
 	// Specifies the key/value pairs of the desktop.
 	Tags []tags.ResourceTag `json:"tags,omitempty"`
+	// EnterpriseProject ID of desktop
+	EnterpriseProjectId string `json:"enterprise_project_id,omitempty"`
 }
 
 // DesktopConfig is an object to specified the basic configuration of desktop.
@@ -216,3 +218,64 @@ func ExpandVolumes(c *golangsdk.ServiceClient, opts VolumeExpandOpts) (*ExpandVo
 	})
 	return &r, err
 }
+
+// RebuildOpts is the structure that used to modify desktop image and os.
+type RebuildOpts struct {
+	// ID list of workspace desktops that wants to rebuild.
+	DesktopIds []string `json:"desktop_ids" required:"true"`
+	// New image type.
+	ImageType string `json:"image_type" required:"true"`
+	// New image ID.
+	ImageId string `json:"image_id" required:"true"`
+	// New OS type.
+	OsType string `json:"os_type,omitempty"`
+	// Delay time.
+	DelayTime string `json:"delay_time,omitempty"`
+	// Rebuild message send to the users.
+	Message string `json:"message,omitempty"`
+	// Enterprise project ID.
+	EnterpriseProjectId string `json:"enterprise_project_id,omitempty"`
+}
+
+// Rebuild is the method that used to modify desktop using given parameters.
+func Rebuild(c *golangsdk.ServiceClient, opts RebuildOpts) (*RebuildResp, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var r RebuildResp
+	_, err = c.Post(rebuildURL(c), b, &r, &golangsdk.RequestOpts{
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return &r, err
+}
+
+// ActionOpts is the structure that used to operate the power state of desktops.
+type ActionOpts struct {
+	// ID list of workspace desktops that wants to operate.
+	DesktopIds []string `json:"desktop_ids" required:"true"`
+	// Operation type, the valid values are as follows:
+	// + os-start
+	// + os-stop
+	// + reboot
+	// + os-hibernate
+	// + os-resume
+	OpType string `json:"op_type" required:"true"`
+	// Operation mode, the valid values are SOFT and HARD.
+	Type string `json:"type,omitempty"`
+}
+
+// DoAction is the method that used to start, stop, reboot, hibernate or resume the desktops.
+func DoAction(c *golangsdk.ServiceClient, opts ActionOpts) (*ActionResp, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var r ActionResp
+	_, err = c.Post(actionURL(c), b, &r, &golangsdk.RequestOpts{
+		MoreHeaders: requestOpts.MoreHeaders,
+	})
+	return &r, err
+}
@@ -130,6 +130,8 @@ type Desktop struct {
 	OrderId string `json:"order_id"`
 	// The key/value pairs of the desktop.
 	Tags []tags.ResourceTag `json:"tags"`
+	// EnterpriseProject ID of desktop
+	EnterpriseProjectId string `json:"enterprise_project_id"`
 }
 
 // AddressInfo is an object to specified the IP address details of desktop.
@@ -199,3 +201,18 @@ type ProductInfo struct {
 	// Charging info.
 	ChargingMode string `json:"charge_mode"`
 }
+
+// RebuildResp is the structure that represents the response of the Rebuild method.
+type RebuildResp struct {
+	// Job ID.
+	JobId string `json:"job_id"`
+	// Error Code.
+	ErrorCode string `json:"error_code"`
+	// Error message.
+	ErrorMsg string `json:"error_msg"`
+}
+
+// ActionResp is the structure that represents the response of the DoAction method.
+type ActionResp struct {
+	RequestResp
+}
@@ -21,3 +21,11 @@ func volumeURL(c *golangsdk.ServiceClient) string {
 func volumeExpandURL(c *golangsdk.ServiceClient) string {
 	return c.ServiceURL("volumes/expand")
 }
+
+func rebuildURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("desktops/rebuild")
+}
+
+func actionURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("desktops/action")
+}
@@ -64,6 +64,7 @@ var (
 	HCS_DEST_REGION          = os.Getenv("HCS_DEST_REGION")
 	HCS_DEST_PROJECT_ID      = os.Getenv("HCS_DEST_PROJECT_ID")
 	HCS_DEST_PROJECT_ID_TEST = os.Getenv("HCS_DEST_PROJECT_ID_TEST")
+	HCS_DEST_PROJECT_NAME    = os.Getenv("HCS_DEST_PROJECT_NAME")
 	HCS_CHARGING_MODE        = os.Getenv("HCS_CHARGING_MODE")
 	HCS_HIGH_COST_ALLOW      = os.Getenv("HCS_HIGH_COST_ALLOW")
 	HCS_SWR_SHARING_ACCOUNT  = os.Getenv("HCS_SWR_SHARING_ACCOUNT")
@@ -88,6 +89,7 @@ var (
 	HCS_DLI_DS_AUTH_KRB_CONF_OBS_PATH    = os.Getenv("HCS_DLI_DS_AUTH_KRB_CONF_OBS_PATH")
 	HCS_DLI_DS_AUTH_KRB_TAB_OBS_PATH     = os.Getenv("HCS_DLI_DS_AUTH_KRB_TAB_OBS_PATH")
 	HCS_DLI_AGENCY_FLAG                  = os.Getenv("HCS_DLI_AGENCY_FLAG")
+	HCS_DLI_USER_NAME                    = os.Getenv("HCS_DLI_USER_NAME")
 
 	HCS_GITHUB_REPO_HOST        = os.Getenv("HCS_GITHUB_REPO_HOST")        // Repository host (Github, Gitlab, Gitee)
 	HCS_GITHUB_PERSONAL_TOKEN   = os.Getenv("HCS_GITHUB_PERSONAL_TOKEN")   // Personal access token (Github, Gitlab, Gitee)
@@ -120,6 +122,13 @@ var (
 
 	HCS_FGS_TRIGGER_LTS_AGENCY = os.Getenv("HCS_FGS_TRIGGER_LTS_AGENCY")
 
+	HCS_DIS_DUMP_TASK_AGENCY = os.Getenv("HCS_DIS_DUMP_TASK_AGENCY")
+	// The DWS cluster and the table used as the destination of the DIS dump task, and the password of the dbadmin user
+	HCS_DIS_DWS_CLUSTER_NAME = os.Getenv("HCS_DIS_DWS_CLUSTER_NAME")
+	HCS_DIS_DWS_CLUSTER_ID   = os.Getenv("HCS_DIS_DWS_CLUSTER_ID")
+	HCS_DIS_DWS_TABLE_NAME   = os.Getenv("HCS_DIS_DWS_TABLE_NAME")
+	HCS_DIS_DWS_PASSWORD     = os.Getenv("HCS_DIS_DWS_PASSWORD")
+
 	HCS_KMS_ENVIRONMENT    = os.Getenv("HCS_KMS_ENVIRONMENT")
 	HCS_KMS_KEY_ID         = os.Getenv("HCS_KMS_KEY_ID")
 	HCS_KMS_HSM_CLUSTER_ID = os.Getenv("HCS_KMS_HSM_CLUSTER_ID")
@@ -148,6 +157,14 @@ var (
 	HCS_CCE_CLUSTER_ID = os.Getenv("HCS_CCE_CLUSTER_ID")
 	// The partition az of the CCE
 	HCS_CCE_PARTITION_AZ = os.Getenv("HCS_CCE_PARTITION_AZ")
+	// The namespace name of the CCI used to create the PVCs
+	HCS_CCI_NAMESPACE = os.Getenv("HCS_CCI_NAMESPACE")
+	// The accelerated domain name of the CDN, and the certificate used to enable HTTPS for it
+	HCS_CDN_DOMAIN_NAME      = os.Getenv("HCS_CDN_DOMAIN_NAME")
+	HCS_CDN_CERT_PATH        = os.Getenv("HCS_CDN_CERT_PATH")
+	HCS_CDN_PRIVATE_KEY_PATH = os.Getenv("HCS_CDN_PRIVATE_KEY_PATH")
+	// The volume type to which the EVS volumes are retyped
+	HCS_EVS_RETYPE_VOLUME_TYPE = os.Getenv("HCS_EVS_RETYPE_VOLUME_TYPE")
 	// The namespace of the workload is located
 	HCS_WORKLOAD_NAMESPACE = os.Getenv("HCS_WORKLOAD_NAMESPACE")
 	// The workload type deployed in CCE/CCI
@@ -165,6 +182,11 @@ var (
 	// The SecMaster workspace ID
 	HCS_SECMASTER_WORKSPACE_ID = os.Getenv("HCS_SECMASTER_WORKSPACE_ID")
 
+	// The source object of the MAAS migration task
+	HCS_MAAS_SRC_REGION     = os.Getenv("HCS_MAAS_SRC_REGION")
+	HCS_MAAS_SRC_BUCKET     = os.Getenv("HCS_MAAS_SRC_BUCKET")
+	HCS_MAAS_SRC_OBJECT_KEY = os.Getenv("HCS_MAAS_SRC_OBJECT_KEY")
+
 	// Deprecated
 	HCS_SRC_ACCESS_KEY = os.Getenv("HCS_SRC_ACCESS_KEY")
 	HCS_SRC_SECRET_KEY = os.Getenv("HCS_SRC_SECRET_KEY")
@@ -340,6 +362,50 @@ func TestAccPreCheckMrsCustom(t *testing.T) {
 	}
 }
 
+// lintignore:AT003
+func TestAccPreCheckDisDumpTask(t *testing.T) {
+	if HCS_DIS_DUMP_TASK_AGENCY == "" {
+		t.Skip("HCS_DIS_DUMP_TASK_AGENCY must be set for DIS dump task acceptance tests")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckDisDumpTaskDws(t *testing.T) {
+	if HCS_DIS_DWS_CLUSTER_NAME == "" || HCS_DIS_DWS_CLUSTER_ID == "" || HCS_DIS_DWS_TABLE_NAME == "" ||
+		HCS_DIS_DWS_PASSWORD == "" {
+		t.Skip("HCS_DIS_DWS_CLUSTER_NAME, HCS_DIS_DWS_CLUSTER_ID, HCS_DIS_DWS_TABLE_NAME and HCS_DIS_DWS_PASSWORD " +
+			"must be set for DIS dump task acceptance tests with DWS destination")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckCCINamespace(t *testing.T) {
+	if HCS_CCI_NAMESPACE == "" {
+		t.Skip("HCS_CCI_NAMESPACE must be set for CCI PVC acceptance tests")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckCDN(t *testing.T) {
+	if HCS_CDN_DOMAIN_NAME == "" {
+		t.Skip("HCS_CDN_DOMAIN_NAME must be set for CDN acceptance tests")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckCDNCert(t *testing.T) {
+	if HCS_CDN_CERT_PATH == "" || HCS_CDN_PRIVATE_KEY_PATH == "" {
+		t.Skip("HCS_CDN_CERT_PATH and HCS_CDN_PRIVATE_KEY_PATH must be set for CDN certificate acceptance tests")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckEvsRetype(t *testing.T) {
+	if HCS_EVS_RETYPE_VOLUME_TYPE == "" {
+		t.Skip("HCS_EVS_RETYPE_VOLUME_TYPE must be set for EVS volume retype acceptance tests")
+	}
+}
+
 // lintignore:AT003
 func TestAccPreCheckFgsTrigger(t *testing.T) {
 	if HCS_FGS_TRIGGER_LTS_AGENCY == "" {
@@ -355,6 +421,14 @@ func TestAccPreCheckMaas(t *testing.T) {
 	}
 }
 
+// lintignore:AT003
+func TestAccPreCheckMaasSource(t *testing.T) {
+	if HCS_MAAS_SRC_REGION == "" || HCS_MAAS_SRC_BUCKET == "" || HCS_MAAS_SRC_OBJECT_KEY == "" {
+		t.Skip("HCS_MAAS_SRC_REGION, HCS_MAAS_SRC_BUCKET and HCS_MAAS_SRC_OBJECT_KEY must be set for MAAS task " +
+			"acceptance tests")
+	}
+}
+
 func RandomAccResourceName() string {
 	return fmt.Sprintf("tf_test_%s", acctest.RandString(5))
 }
@@ -419,6 +493,13 @@ func TestAccPreCheckReplication(t *testing.T) {
 	}
 }
 
+// lintignore:AT003
+func TestAccPreCheckDestProject(t *testing.T) {
+	if HCS_DEST_PROJECT_ID == "" || HCS_DEST_PROJECT_NAME == "" {
+		t.Skip("HCS_DEST_PROJECT_ID and HCS_DEST_PROJECT_NAME must be set for the cross-project acceptance tests")
+	}
+}
+
 // lintignore:AT003
 func TestAccPreCheckProjectId(t *testing.T) {
 	if HCS_DEST_PROJECT_ID_TEST == "" {
@@ -543,6 +624,13 @@ func TestAccPreCheckDliAgency(t *testing.T) {
 	}
 }
 
+// lintignore:AT003
+func TestAccPreCheckDliUserName(t *testing.T) {
+	if HCS_DLI_USER_NAME == "" {
+		t.Skip("HCS_DLI_USER_NAME must be set for DLI permission acceptance tests.")
+	}
+}
+
 // lintignore:AT003
 func TestAccPreCheckRepoTokenAuth(t *testing.T) {
 	if HCS_GITHUB_REPO_HOST == "" || HCS_GITHUB_PERSONAL_TOKEN == "" {
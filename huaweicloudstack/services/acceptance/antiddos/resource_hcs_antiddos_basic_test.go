@@ -0,0 +1,100 @@
+package antiddos
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	antiddossdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/antiddos/v1/antiddos"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccAntiDdos_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "hcs_antiddos_basic.antiddos_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckAntiDdosDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAntiDdos_config(rName, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAntiDdosExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "traffic_threshold", "200"),
+					resource.TestCheckResourceAttr(resourceName, "status", "normal"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", "hcs_vpc_eip.eip_1", "address"),
+				),
+			},
+			{
+				Config: testAccAntiDdos_config(rName, 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "traffic_threshold", "300"),
+					resource.TestCheckResourceAttr(resourceName, "status", "normal"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", "hcs_vpc_eip.eip_1", "address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAntiDdosDestroy(_ *terraform.State) error {
+	// the cloud native AntiDdos always exists
+	return nil
+}
+
+func testAccCheckAntiDdosExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		cfg := acceptance.TestAccProvider.Meta().(*config.HcsConfig)
+		client, err := cfg.AntiDDosV1Client(acceptance.HCS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating antiddos client: %s", err)
+		}
+
+		_, err = antiddossdk.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return fmt.Errorf("error retrieving cloud native AntiDdos: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAntiDdos_config(rName string, threshold int) string {
+	return fmt.Sprintf(`
+resource "hcs_vpc_eip" "eip_1" {
+  publicip {
+    type = "%[1]s"
+  }
+  bandwidth {
+    share_type = "PER"
+    name       = "%[2]s"
+    size       = 5
+  }
+}
+
+resource "hcs_antiddos_basic" "antiddos_1" {
+  eip_id            = hcs_vpc_eip.eip_1.id
+  traffic_threshold = %[3]d
+}
+`, acceptance.HCS_EIP_EXTERNAL_NETWORK_NAME, rName, threshold)
+}
@@ -0,0 +1,134 @@
+package apig
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/apigw/dedicated/v2/environments"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/apig"
+)
+
+func getEnvironmentFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.ApigV2Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating APIG v2 client: %s", err)
+	}
+
+	return apig.GetEnvironmentFormServer(client, state.Primary.Attributes["instance_id"], state.Primary.ID)
+}
+
+func TestAccEnvironment_basic(t *testing.T) {
+	var (
+		env environments.Environment
+
+		rName = "hcs_apig_environment.test"
+		// Only letters, digits and underscores (_) are allowed in the environment name and dedicated instance name.
+		name       = acceptance.RandomAccResourceName()
+		updateName = acceptance.RandomAccResourceName()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&env,
+		getEnvironmentFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironment_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "Created by script"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testAccEnvironment_update(updateName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", updateName),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEnvironmentImportStateFunc(),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentImportStateFunc() resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rName := "hcs_apig_environment.test"
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", rName, rs)
+		}
+		if rs.Primary.Attributes["instance_id"] == "" || rs.Primary.Attributes["name"] == "" {
+			return "", fmt.Errorf("missing some attributes, want '{instance_id}/{name}', but '%s/%s'",
+				rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["name"])
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
+func testAccEnvironment_base(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "hcs_availability_zones" "test" {}
+
+resource "hcs_apig_instance" "test" {
+  name                  = "%s"
+  edition               = "BASIC"
+  vpc_id                = hcs_vpc.test.id
+  subnet_id             = hcs_vpc_subnet.test.id
+  security_group_id     = hcs_networking_secgroup.test.id
+  enterprise_project_id = "0"
+
+  availability_zones = [
+    data.hcs_availability_zones.test.names[0],
+  ]
+}
+`, common.TestBaseNetwork(name), name)
+}
+
+func testAccEnvironment_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_apig_environment" "test" {
+  name        = "%[2]s"
+  instance_id = hcs_apig_instance.test.id
+  description = "Created by script"
+}
+`, testAccEnvironment_base(name), name)
+}
+
+func testAccEnvironment_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_apig_environment" "test" {
+  name        = "%[2]s"
+  instance_id = hcs_apig_instance.test.id
+}
+`, testAccEnvironment_base(name), name)
+}
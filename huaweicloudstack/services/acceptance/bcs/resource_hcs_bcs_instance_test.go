@@ -0,0 +1,296 @@
+package bcs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/bcs/v2/blockchains"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getInstanceResourceFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.BcsV2Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating BCS v2 client: %s", err)
+	}
+
+	instance, err := blockchains.Get(client, state.Primary.ID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	if instance.Basic.ID == "" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return instance, nil
+}
+
+func TestAccBcsInstance_basic(t *testing.T) {
+	var (
+		instance blockchains.BCSInstance
+
+		rName    = "hcs_bcs_instance.test"
+		name     = acceptance.RandomAccResourceNameWithDash()
+		password = fmt.Sprintf("%s%s%d", acctest.RandString(5),
+			acctest.RandStringFromCharSet(3, "!@$%^-_=+[{}]:,./?"), acctest.RandIntRange(1, 3))
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&instance,
+		getInstanceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckEpsID(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBcsInstance_basic(name, password),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "edition", "4"),
+					resource.TestCheckResourceAttr(rName, "consensus", "etcdraft"),
+					resource.TestCheckResourceAttr(rName, "fabric_version", "2.0"),
+					resource.TestCheckResourceAttr(rName, "blockchain_type", "private"),
+					resource.TestCheckResourceAttr(rName, "enterprise_project_id",
+						acceptance.HCS_ENTERPRISE_PROJECT_ID_TEST),
+					resource.TestCheckResourceAttr(rName, "volume_type", "nfs"),
+					resource.TestCheckResourceAttr(rName, "org_disk_size", "100"),
+					resource.TestCheckResourceAttr(rName, "security_mechanism", "ECDSA"),
+					resource.TestCheckResourceAttr(rName, "database_type", "goleveldb"),
+					resource.TestCheckResourceAttr(rName, "orderer_node_num", "3"),
+					resource.TestCheckResourceAttr(rName, "channels.#", "1"),
+					resource.TestCheckResourceAttr(rName, "peer_orgs.#", "1"),
+					resource.TestCheckResourceAttrPair(rName, "cce_cluster_id", "hcs_cce_cluster.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBcsInstance_kafka(t *testing.T) {
+	var (
+		instance blockchains.BCSInstance
+
+		rName    = "hcs_bcs_instance.test"
+		name     = acceptance.RandomAccResourceNameWithDash()
+		password = fmt.Sprintf("%s%s%d", acctest.RandString(5),
+			acctest.RandStringFromCharSet(3, "!@$%^-_=+[{}]:,./?"), acctest.RandIntRange(1, 3))
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&instance,
+		getInstanceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckEpsID(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBcsInstance_kafka(name, password),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "consensus", "kafka"),
+					resource.TestCheckResourceAttr(rName, "fabric_version", "1.4"),
+					resource.TestCheckResourceAttr(rName, "volume_type", "efs"),
+					resource.TestCheckResourceAttr(rName, "database_type", "couchdb"),
+					resource.TestCheckResourceAttr(rName, "orderer_node_num", "2"),
+					resource.TestCheckResourceAttr(rName, "couchdb.0.user_name", "Administrator"),
+					resource.TestCheckResourceAttr(rName, "tc3_need", "false"),
+					resource.TestCheckResourceAttr(rName, "channels.#", "2"),
+					resource.TestCheckResourceAttr(rName, "peer_orgs.#", "2"),
+					resource.TestCheckResourceAttrSet(rName, "kafka.0.name"),
+				),
+			},
+			{
+				// The CouchDB configuration must not change the TC3 flag and replace the instance.
+				Config:   testAccBcsInstance_kafka(name, password),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccBcsInstance_base(name string) string {
+	return fmt.Sprintf(`
+data "hcs_availability_zones" "test" {}
+
+data "hcs_ecs_compute_flavors" "test" {
+  availability_zone = data.hcs_availability_zones.test.names[0]
+  performance_type  = "normal"
+  cpu_core_count    = 4
+  memory_size       = 8
+}
+
+resource "hcs_ecs_compute_keypair" "test" {
+  name = "%[1]s"
+}
+
+resource "hcs_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "hcs_vpc_subnet" "test" {
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = hcs_vpc.test.id
+}
+
+resource "hcs_vpc_eip" "test" {
+  publicip {
+    type = "5_bgp"
+  }
+
+  bandwidth {
+    share_type  = "PER"
+    size        = 5
+    name        = "%[1]s"
+    charge_mode = "traffic"
+  }
+}
+
+resource "hcs_cce_cluster" "test" {
+  name                   = "%[1]s"
+  flavor_id              = "cce.s2.small"
+  vpc_id                 = hcs_vpc.test.id
+  subnet_id              = hcs_vpc_subnet.test.id
+  container_network_type = "overlay_l2"
+  service_network_cidr   = "10.248.0.0/16"
+  delete_sfs             = true
+}
+
+resource "hcs_cce_node" "test" {
+  cluster_id        = hcs_cce_cluster.test.id
+  name              = "%[1]s"
+  flavor_id         = data.hcs_ecs_compute_flavors.test.ids[0]
+  availability_zone = data.hcs_availability_zones.test.names[0]
+  key_pair          = hcs_ecs_compute_keypair.test.name
+  eip_id            = hcs_vpc_eip.test.id
+
+  root_volume {
+    volumetype = "SAS"
+    size       = 40
+  }
+
+  data_volumes {
+    volumetype = "SAS"
+    size       = 100
+  }
+}
+`, name)
+}
+
+func testAccBcsInstance_basic(name, password string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_bcs_instance" "test" {
+  depends_on = [hcs_cce_node.test]
+
+  name                  = "%[2]s"
+  cce_cluster_id        = hcs_cce_cluster.test.id
+  consensus             = "etcdraft"
+  edition               = 4
+  enterprise_project_id = "%[3]s"
+  fabric_version        = "2.0"
+  password              = "%[4]s"
+  volume_type           = "nfs"
+  org_disk_size         = 100
+  security_mechanism    = "ECDSA"
+  orderer_node_num      = 3
+  delete_storage        = true
+
+  peer_orgs {
+    org_name = "organization01"
+    count    = 1
+  }
+
+  channels {
+    name      = "channeldemo001"
+    org_names = ["organization01"]
+  }
+}
+`, testAccBcsInstance_base(name), name, acceptance.HCS_ENTERPRISE_PROJECT_ID_TEST, password)
+}
+
+func testAccBcsInstance_kafka(name, password string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_bcs_instance" "test" {
+  depends_on = [hcs_cce_node.test]
+
+  name                  = "%[2]s"
+  cce_cluster_id        = hcs_cce_cluster.test.id
+  consensus             = "kafka"
+  edition               = 4
+  enterprise_project_id = "%[3]s"
+  fabric_version        = "1.4"
+  password              = "%[4]s"
+  volume_type           = "efs"
+  org_disk_size         = 500
+  database_type         = "couchdb"
+  tc3_need              = false
+  orderer_node_num      = 2
+  delete_storage        = true
+  delete_obs            = true
+
+  couchdb {
+    user_name = "Administrator"
+    password  = "%[4]s"
+  }
+
+  peer_orgs {
+    org_name = "organization01"
+    count    = 2
+  }
+  peer_orgs {
+    org_name = "organization02"
+    count    = 2
+  }
+
+  channels {
+    name      = "channeldemo001"
+    org_names = ["organization01", "organization02"]
+  }
+  channels {
+    name      = "channeldemo002"
+    org_names = ["organization02"]
+  }
+
+  sfs_turbo {
+    share_type        = "STANDARD"
+    type              = "efs-ha"
+    flavor            = "sfs.turbo.standard"
+    availability_zone = data.hcs_availability_zones.test.names[0]
+  }
+
+  kafka {
+    flavor            = "c3.mini"
+    storage_size      = 600
+    availability_zone = [data.hcs_availability_zones.test.names[0]]
+  }
+}
+`, testAccBcsInstance_base(name), name, acceptance.HCS_ENTERPRISE_PROJECT_ID_TEST, password)
+}
@@ -0,0 +1,112 @@
+package cci
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/cci/v1/networks"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance/common"
+)
+
+func getNetworkResourceFunc(conf *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.CciV1BetaClient(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating HuaweiCloudStack CCI Beta v1 client: %s", err)
+	}
+	return networks.Get(c, state.Primary.Attributes["namespace"], state.Primary.ID).Extract()
+}
+
+func TestAccCciNetwork_basic(t *testing.T) {
+	var network networks.Network
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "hcs_cci_network.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&network,
+		getNetworkResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCciNetwork_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "cidr"),
+					resource.TestCheckResourceAttrPair(resourceName, "namespace",
+						"hcs_cci_namespace.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_id",
+						"hcs_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id",
+						"hcs_vpc_subnet.test", "subnet_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id",
+						"hcs_networking_secgroup.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id",
+						"hcs_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone",
+						"data.hcs_availability_zones.test", "names.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccCciNetworkImportStateFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccCciNetworkImportStateFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("Resource (%s) not found: %s", name, rs)
+		}
+		if rs.Primary.ID == "" || rs.Primary.Attributes["namespace"] == "" {
+			return "", fmt.Errorf("the namespace name (%s) or network ID (%s) is nil",
+				rs.Primary.Attributes["namespace"], rs.Primary.ID)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["namespace"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCciNetwork_base(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "hcs_cci_namespace" "test" {
+  name = "%s"
+  type = "general-computing"
+}
+`, common.TestBaseNetwork(rName), rName)
+}
+
+func testAccCciNetwork_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "hcs_availability_zones" "test" {}
+
+resource "hcs_cci_network" "test" {
+  name              = "%s"
+  availability_zone = data.hcs_availability_zones.test.names[0]
+  namespace         = hcs_cci_namespace.test.name
+  network_id        = hcs_vpc_subnet.test.id
+  security_group_id = hcs_networking_secgroup.test.id
+}
+`, testAccCciNetwork_base(rName), rName)
+}
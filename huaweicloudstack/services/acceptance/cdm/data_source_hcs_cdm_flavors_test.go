@@ -0,0 +1,38 @@
+package cdm
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccDataSourceCdmFlavors_basic(t *testing.T) {
+	var (
+		dName = "data.hcs_cdm_flavors.test"
+		dc    = acceptance.InitDataSourceCheck(dName)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCdmFlavors_basic,
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(dName, "version"),
+					resource.TestMatchResourceAttr(dName, "flavors.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckResourceAttrSet(dName, "flavors.0.id"),
+					resource.TestCheckResourceAttrSet(dName, "flavors.0.name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceCdmFlavors_basic = `
+data "hcs_cdm_flavors" "test" {}
+`
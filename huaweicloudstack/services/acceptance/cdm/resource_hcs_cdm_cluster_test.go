@@ -0,0 +1,168 @@
+package cdm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/cdm/v1/clusters"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance/common"
+)
+
+func getCdmClusterResourceFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.CdmV11Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CDM v1 client, err=%s", err)
+	}
+	return clusters.Get(client, state.Primary.ID)
+}
+
+func TestAccResourceCdmCluster_basic(t *testing.T) {
+	var obj clusters.ClusterCreateOpts
+	resourceName := "hcs_cdm_cluster.test"
+	name := acceptance.RandomAccResourceName()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getCdmClusterResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdmCluster_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "status", "Normal"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+					resource.TestCheckResourceAttrSet(resourceName, "created"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCdmCluster_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "hcs_availability_zones" "test" {}
+
+data "hcs_cdm_flavors" "test" {}
+
+resource "hcs_cdm_cluster" "test" {
+  availability_zone = data.hcs_availability_zones.test.names[0]
+  flavor_id         = data.hcs_cdm_flavors.test.flavors[0].id
+  name              = "%s"
+  security_group_id = hcs_networking_secgroup.test.id
+  subnet_id         = hcs_vpc_subnet.test.id
+  vpc_id            = hcs_vpc.test.id
+}
+`, common.TestBaseNetwork(name), name)
+}
+
+func TestAccResourceCdmCluster_all(t *testing.T) {
+	var obj clusters.ClusterCreateOpts
+	resourceName := "hcs_cdm_cluster.test"
+	name := acceptance.RandomAccResourceName()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getCdmClusterResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdmCluster_all(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "is_auto_off", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Normal"),
+					resource.TestCheckResourceAttrSet(resourceName, "created"),
+				),
+			},
+			{
+				Config: testAccCdmCluster_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "is_auto_off", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Normal"),
+					resource.TestCheckResourceAttrSet(resourceName, "created"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"email", "phone_num"},
+			},
+		},
+	})
+}
+
+func testAccCdmCluster_all(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "hcs_availability_zones" "test" {}
+
+data "hcs_cdm_flavors" "test" {}
+
+resource "hcs_cdm_cluster" "test" {
+  availability_zone = data.hcs_availability_zones.test.names[0]
+  flavor_id         = data.hcs_cdm_flavors.test.flavors[0].id
+  name              = "%s"
+  security_group_id = hcs_networking_secgroup.test.id
+  subnet_id         = hcs_vpc_subnet.test.id
+  vpc_id            = hcs_vpc.test.id
+  is_auto_off       = true
+  email             = ["test@test.com"]
+  phone_num         = ["12345678910"]
+}
+`, common.TestBaseNetwork(name), name)
+}
+
+func testAccCdmCluster_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "hcs_availability_zones" "test" {}
+
+data "hcs_cdm_flavors" "test" {}
+
+resource "hcs_cdm_cluster" "test" {
+  availability_zone  = data.hcs_availability_zones.test.names[0]
+  flavor_id          = data.hcs_cdm_flavors.test.flavors[0].id
+  name               = "%s"
+  security_group_id  = hcs_networking_secgroup.test.id
+  subnet_id          = hcs_vpc_subnet.test.id
+  vpc_id             = hcs_vpc.test.id
+  email              = ["test@test.com"]
+  phone_num          = ["12345678910"]
+  schedule_boot_time = "00:00:00"
+  schedule_off_time  = "10:00:00"
+}
+`, common.TestBaseNetwork(name), name)
+}
@@ -0,0 +1,56 @@
+package cdn
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/cdn/v1/model"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccCdnCachePreload_basic(t *testing.T) {
+	var (
+		obj   model.ShowHistoryTaskDetailsResponse
+		rName = "hcs_cdn_cache_preload.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getCacheTaskFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCDN(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdnCachePreload_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "urls.#", "2"),
+					resource.TestCheckResourceAttr(rName, "status", "task_done"),
+					resource.TestCheckResourceAttr(rName, "total", "2"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCdnCachePreload_basic() string {
+	return fmt.Sprintf(`
+resource "hcs_cdn_cache_preload" "test" {
+  urls = [
+    "http://%[1]s/index.html",
+    "http://%[1]s/error.html",
+  ]
+}
+`, acceptance.HCS_CDN_DOMAIN_NAME)
+}
@@ -0,0 +1,68 @@
+package cdn
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/cdn/v1/model"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getCacheTaskFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.HcCdnV1Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CDN v1 client: %s", err)
+	}
+
+	return client.ShowHistoryTaskDetails(&model.ShowHistoryTaskDetailsRequest{
+		HistoryTasksId: state.Primary.ID,
+	})
+}
+
+func TestAccCdnCacheRefresh_basic(t *testing.T) {
+	var (
+		obj   model.ShowHistoryTaskDetailsResponse
+		rName = "hcs_cdn_cache_refresh.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getCacheTaskFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCDN(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdnCacheRefresh_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "type", "directory"),
+					resource.TestCheckResourceAttr(rName, "mode", "all"),
+					resource.TestCheckResourceAttr(rName, "status", "task_done"),
+					resource.TestCheckResourceAttr(rName, "total", "1"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCdnCacheRefresh_basic() string {
+	return fmt.Sprintf(`
+resource "hcs_cdn_cache_refresh" "test" {
+  type = "directory"
+  urls = ["http://%s/test/"]
+}
+`, acceptance.HCS_CDN_DOMAIN_NAME)
+}
@@ -0,0 +1,415 @@
+package cdn
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/cdn/v1/domains"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getResourceExtensionOpts(epsId string) *domains.ExtensionOpts {
+	if epsId != "" {
+		return &domains.ExtensionOpts{
+			EnterpriseProjectId: epsId,
+		}
+	}
+	return nil
+}
+
+func getCdnDomainFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.CdnV1Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CDN v1 client: %s", err)
+	}
+
+	opts := getResourceExtensionOpts(state.Primary.Attributes["enterprise_project_id"])
+	return domains.Get(client, state.Primary.ID, opts).Extract()
+}
+
+func TestAccCdnDomain_basic(t *testing.T) {
+	var (
+		domain       domains.CdnDomain
+		resourceName = "hcs_cdn_domain.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&domain,
+		getCdnDomainFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheckCDN(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdnDomainV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", acceptance.HCS_CDN_DOMAIN_NAME),
+					resource.TestCheckResourceAttr(resourceName, "configs.0.origin_protocol", "http"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "val"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCdnDomainV1_basic = fmt.Sprintf(`
+resource "hcs_cdn_domain" "test" {
+  name                  = "%s"
+  type                  = "web"
+  service_area          = "outside_mainland_china"
+  enterprise_project_id = "0"
+
+  configs {
+    origin_protocol = "http"
+  }
+
+  sources {
+    active      = 1
+    origin      = "100.254.53.75"
+    origin_type = "ipaddr"
+    http_port   = 80
+    https_port  = 443
+  }
+
+  tags = {
+    key = "val"
+    foo = "bar"
+  }
+}
+`, acceptance.HCS_CDN_DOMAIN_NAME)
+
+func TestAccCdnDomain_cache(t *testing.T) {
+	var (
+		domain       domains.CdnDomain
+		resourceName = "hcs_cdn_domain.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&domain,
+		getCdnDomainFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheckCDN(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdnDomainV1_cache,
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", acceptance.HCS_CDN_DOMAIN_NAME),
+					resource.TestCheckResourceAttr(resourceName, "cache_settings.0.rules.0.rule_type", "all"),
+					resource.TestCheckResourceAttr(resourceName, "cache_settings.0.rules.0.ttl", "180"),
+					resource.TestCheckResourceAttr(resourceName, "cache_settings.0.rules.0.ttl_type", "d"),
+					resource.TestCheckResourceAttr(resourceName, "cache_settings.0.rules.0.priority", "2"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCdnDomainV1_cache = fmt.Sprintf(`
+resource "hcs_cdn_domain" "test" {
+  name                  = "%s"
+  type                  = "web"
+  service_area          = "outside_mainland_china"
+  enterprise_project_id = "0"
+
+  configs {
+    origin_protocol = "http"
+  }
+
+  sources {
+    active      = 1
+    origin      = "100.254.53.75"
+    origin_type = "ipaddr"
+    http_port   = 80
+    https_port  = 443
+  }
+
+  cache_settings {
+    rules {
+      rule_type = 0
+      ttl       = 180
+      ttl_type  = 4
+      priority  = 2
+    }
+  }
+}
+`, acceptance.HCS_CDN_DOMAIN_NAME)
+
+func TestAccCdnDomain_retrievalHost(t *testing.T) {
+	var (
+		domain       domains.CdnDomain
+		resourceName = "hcs_cdn_domain.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&domain,
+		getCdnDomainFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheckCDN(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdnDomainV1_retrievalHost,
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", acceptance.HCS_CDN_DOMAIN_NAME),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.retrieval_host", "customize.test.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.http_port", "8001"),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.https_port", "8002"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCdnDomainV1_retrievalHost = fmt.Sprintf(`
+resource "hcs_cdn_domain" "test" {
+  name                  = "%s"
+  type                  = "web"
+  service_area          = "outside_mainland_china"
+  enterprise_project_id = 0
+
+  configs {
+    origin_protocol = "http"
+  }
+
+  sources {
+    active         = 1
+    origin         = "100.254.53.75"
+    origin_type    = "ipaddr"
+    retrieval_host = "customize.test.example.com"
+    http_port      = 8001
+    https_port     = 8002
+  }
+}
+`, acceptance.HCS_CDN_DOMAIN_NAME)
+
+func TestAccCdnDomain_configs(t *testing.T) {
+	var (
+		domain       domains.CdnDomain
+		resourceName = "hcs_cdn_domain.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&domain,
+		getCdnDomainFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheckCDN(t)
+			acceptance.TestAccPreCheckCDNCert(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdnDomainV1_configs,
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", acceptance.HCS_CDN_DOMAIN_NAME),
+					resource.TestCheckResourceAttr(resourceName, "configs.0.origin_protocol", "http"),
+					resource.TestCheckResourceAttr(resourceName, "configs.0.ipv6_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "configs.0.range_based_retrieval_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "configs.0.https_settings.0.certificate_name", "terraform-test"),
+					resource.TestCheckResourceAttr(resourceName, "configs.0.https_settings.0.https_status", "on"),
+					resource.TestCheckResourceAttr(resourceName, "configs.0.https_settings.0.http2_status", "on"),
+					resource.TestCheckResourceAttr(resourceName, "configs.0.cache_url_parameter_filter.0.type", "ignore_url_params"),
+					resource.TestCheckResourceAttr(resourceName, "configs.0.retrieval_request_header.0.name", "test-name"),
+					resource.TestCheckResourceAttr(resourceName, "configs.0.url_signing.0.status", "off"),
+					resource.TestCheckResourceAttr(resourceName, "configs.0.compress.0.status", "off"),
+					resource.TestCheckResourceAttr(resourceName, "configs.0.force_redirect.0.status", "on"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCdnDomainV1_configs = fmt.Sprintf(`
+resource "hcs_cdn_domain" "test" {
+  name                  = "%s"
+  type                  = "web"
+  service_area          = "outside_mainland_china"
+  enterprise_project_id = 0
+
+  sources {
+    active      = 1
+    origin      = "100.254.53.75"
+    origin_type = "ipaddr"
+  }
+
+  configs {
+    origin_protocol               = "http"
+    ipv6_enable                   = true
+    range_based_retrieval_enabled = "true"
+
+    https_settings {
+      certificate_name = "terraform-test"
+      certificate_body = file("%s")
+      http2_enabled    = true
+      https_enabled    = true
+      private_key      = file("%s")
+    }
+
+    cache_url_parameter_filter {
+      type = "ignore_url_params"
+    }
+
+    retrieval_request_header {
+      name   = "test-name"
+      value  = "test-val"
+      action = "set"
+    }
+
+    http_response_header {
+      name   = "test-name"
+      value  = "test-val"
+      action = "set"
+    }
+
+    url_signing {
+      enabled = false
+    }
+
+    compress {
+      enabled = false
+    }
+
+    force_redirect {
+      enabled = true
+      type   = "http"
+    }
+  }
+}
+`, acceptance.HCS_CDN_DOMAIN_NAME, acceptance.HCS_CDN_CERT_PATH, acceptance.HCS_CDN_PRIVATE_KEY_PATH)
+
+func TestAccCdnDomain_standby(t *testing.T) {
+	var (
+		domain       domains.CdnDomain
+		resourceName = "hcs_cdn_domain.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&domain,
+		getCdnDomainFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheckCDN(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdnDomainV1_standby,
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", acceptance.HCS_CDN_DOMAIN_NAME),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.active", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sources.1.active", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCdnDomainV1_standby = fmt.Sprintf(`
+resource "hcs_cdn_domain" "test" {
+  name         = "%s"
+  type         = "web"
+  service_area = "outside_mainland_china"
+
+  sources {
+    active      = 1
+    origin      = "14.215.177.39"
+    origin_type = "ipaddr"
+  }
+  sources {
+    active      = 0
+    origin      = "220.181.28.52"
+    origin_type = "ipaddr"
+  }
+}
+`, acceptance.HCS_CDN_DOMAIN_NAME)
+
+func TestAccCdnDomain_obsWebsite(t *testing.T) {
+	var (
+		domain       domains.CdnDomain
+		resourceName = "hcs_cdn_domain.test"
+		bucketName   = acceptance.RandomAccResourceNameWithDash()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&domain,
+		getCdnDomainFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheckCDN(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdnDomainV1_obsWebsite(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", acceptance.HCS_CDN_DOMAIN_NAME),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.origin_type", "obs_bucket"),
+					resource.TestCheckResourceAttr(resourceName, "sources.0.obs_web_hosting_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "sources.0.origin",
+						"hcs_obs_bucket.test", "bucket_domain_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCdnDomainV1_obsWebsite(bucketName string) string {
+	return fmt.Sprintf(`
+resource "hcs_obs_bucket" "test" {
+  bucket        = "%[1]s"
+  acl           = "public-read"
+  force_destroy = true
+
+  website {
+    index_document = "index.html"
+    error_document = "error.html"
+  }
+}
+
+resource "hcs_cdn_domain" "test" {
+  name         = "%[2]s"
+  type         = "web"
+  service_area = "mainland_china"
+
+  sources {
+    active                  = 1
+    origin                  = hcs_obs_bucket.test.bucket_domain_name
+    origin_type             = "obs_bucket"
+    obs_web_hosting_enabled = true
+  }
+}
+`, bucketName, acceptance.HCS_CDN_DOMAIN_NAME)
+}
@@ -0,0 +1,126 @@
+package csbs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/csbs/v1/policies"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getBackupPolicyResourceFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.CsbsV1Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CSBS v1 client: %s", err)
+	}
+	return policies.Get(client, state.Primary.ID).Extract()
+}
+
+func TestAccCSBSBackupPolicy_basic(t *testing.T) {
+	var (
+		obj policies.BackupPolicy
+
+		rName      = "hcs_csbs_backup_policy.test"
+		name       = acceptance.RandomAccResourceNameWithDash()
+		updateName = acceptance.RandomAccResourceNameWithDash()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getBackupPolicyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCSBSBackupPolicy_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "status", "suspended"),
+					resource.TestCheckResourceAttr(rName, "resource.#", "1"),
+					resource.TestCheckResourceAttr(rName, "scheduled_operation.0.name", "mybackup"),
+					resource.TestCheckResourceAttr(rName, "scheduled_operation.0.enabled", "true"),
+					resource.TestCheckResourceAttr(rName, "scheduled_operation.0.operation_type", "backup"),
+					resource.TestCheckResourceAttr(rName, "scheduled_operation.0.max_backups", "2"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testAccCSBSBackupPolicy_update(name, updateName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", updateName),
+					resource.TestCheckResourceAttr(rName, "description", "Updated by acc test"),
+					resource.TestCheckResourceAttr(rName, "scheduled_operation.0.max_backups", "0"),
+					resource.TestCheckResourceAttr(rName, "scheduled_operation.0.retention_duration_days", "7"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCSBSBackupPolicy_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_csbs_backup_policy" "test" {
+  name = "%[2]s"
+
+  resource {
+    id   = hcs_ecs_compute_instance.test.id
+    type = "OS::Nova::Server"
+    name = hcs_ecs_compute_instance.test.name
+  }
+
+  scheduled_operation {
+    name            = "mybackup"
+    enabled         = true
+    operation_type  = "backup"
+    max_backups     = 2
+    trigger_pattern = "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nRRULE:FREQ=WEEKLY;BYDAY=TH;BYHOUR=12;BYMINUTE=27\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+  }
+}
+`, testAccCSBSBackup_base(name), name)
+}
+
+func testAccCSBSBackupPolicy_update(name, updateName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_csbs_backup_policy" "test" {
+  name        = "%[2]s"
+  description = "Updated by acc test"
+
+  resource {
+    id   = hcs_ecs_compute_instance.test.id
+    type = "OS::Nova::Server"
+    name = hcs_ecs_compute_instance.test.name
+  }
+
+  scheduled_operation {
+    name                    = "mybackup"
+    enabled                 = true
+    operation_type          = "backup"
+    retention_duration_days = 7
+    trigger_pattern         = "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nRRULE:FREQ=DAILY;BYHOUR=2;BYMINUTE=0\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+  }
+}
+`, testAccCSBSBackup_base(name), updateName)
+}
@@ -0,0 +1,98 @@
+package csbs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/csbs/v1/backup"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance/common"
+)
+
+func getBackupResourceFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.CsbsV1Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CSBS v1 client: %s", err)
+	}
+	return backup.Get(client, state.Primary.ID).ExtractBackup()
+}
+
+func TestAccCSBSBackup_basic(t *testing.T) {
+	var (
+		obj backup.Backup
+
+		rName = "hcs_csbs_backup.test"
+		name  = acceptance.RandomAccResourceNameWithDash()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getBackupResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCSBSBackup_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "backup_name", name),
+					resource.TestCheckResourceAttr(rName, "description", "Created by acc test"),
+					resource.TestCheckResourceAttr(rName, "resource_type", "OS::Nova::Server"),
+					resource.TestCheckResourceAttrPair(rName, "resource_id", "hcs_ecs_compute_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "status", "available"),
+					resource.TestCheckResourceAttrSet(rName, "backup_record_id"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCSBSBackup_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_ecs_compute_instance" "test" {
+  name               = "%[2]s"
+  image_id           = data.hcs_ims_images.test.images[0].id
+  flavor_id          = data.hcs_ecs_compute_flavors.test.ids[0]
+  security_group_ids = [hcs_networking_secgroup.test.id]
+  availability_zone  = data.hcs_availability_zones.test.names[0]
+  system_disk_type   = "SSD"
+
+  network {
+    uuid = hcs_vpc_subnet.test.id
+  }
+}
+`, common.TestBaseComputeResources(name), name)
+}
+
+func testAccCSBSBackup_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_csbs_backup" "test" {
+  backup_name   = "%[2]s"
+  description   = "Created by acc test"
+  resource_id   = hcs_ecs_compute_instance.test.id
+  resource_type = "OS::Nova::Server"
+}
+`, testAccCSBSBackup_base(name), name)
+}
@@ -0,0 +1,213 @@
+package cse
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/cse/dedicated/v2/engines"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getEngineFunc(conf *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.CseV2Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CSE V2 client: %s", err)
+	}
+	return engines.Get(c, state.Primary.ID, state.Primary.Attributes["enterprise_project_id"])
+}
+
+func TestAccMicroserviceEngine_basic(t *testing.T) {
+	var (
+		engine       engines.Engine
+		randName     = acceptance.RandomAccResourceNameWithDash()
+		resourceName = "hcs_cse_microservice_engine.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&engine,
+		getEngineFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMicroserviceEngine_basic(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", randName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created by terraform test"),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "cse.s1.small2"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_id", "hcs_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "RBAC"),
+					resource.TestCheckResourceAttrSet(resourceName, "admin_pass"),
+					resource.TestCheckResourceAttr(resourceName, "availability_zones.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enterprise_project_id", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_limit"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_limit"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_registry_addresses.0.private"),
+					resource.TestCheckResourceAttrSet(resourceName, "config_center_addresses.0.private"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_pass",
+					"extend_params",
+				},
+			},
+		},
+	})
+}
+
+func testAccMicroserviceEngine_base(rName string) string {
+	return fmt.Sprintf(`
+data "hcs_availability_zones" "test" {}
+
+resource "hcs_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "hcs_vpc_subnet" "test" {
+  name       = "%[1]s"
+  vpc_id     = hcs_vpc.test.id
+  cidr       = "192.168.0.0/16"
+  gateway_ip = "192.168.0.1"
+}
+
+resource "hcs_vpc_eip" "test" {
+  publicip {
+    type = "5_bgp"
+  }
+  
+  bandwidth {
+    share_type  = "PER"
+    size        = 5
+    name        = "%[1]s"
+    charge_mode = "traffic"
+  }
+}
+`, rName)
+}
+
+func testAccMicroserviceEngine_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_cse_microservice_engine" "test" {
+  name                  = "%[2]s"
+  description           = "Created by terraform test"
+  flavor                = "cse.s1.small2"
+  network_id            = hcs_vpc_subnet.test.id
+  eip_id                = hcs_vpc_eip.test.id
+  enterprise_project_id = "0"
+
+  auth_type  = "RBAC"
+  admin_pass = "AccTest!123"
+
+  availability_zones = slice(data.hcs_availability_zones.test.names, 0, 1)
+
+}`, testAccMicroserviceEngine_base(rName), rName)
+}
+
+func TestAccMicroserviceEngine_withEpsId(t *testing.T) {
+	var (
+		engine       engines.Engine
+		randName     = acceptance.RandomAccResourceNameWithDash()
+		resourceName = "hcs_cse_microservice_engine.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&engine,
+		getEngineFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckEpsID(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMicroserviceEngine_withEpsId(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", randName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created by terraform test"),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "cse.s1.small2"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_id", "hcs_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "auth_type", "RBAC"),
+					resource.TestCheckResourceAttrSet(resourceName, "admin_pass"),
+					resource.TestCheckResourceAttr(resourceName, "availability_zones.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enterprise_project_id",
+						acceptance.HCS_ENTERPRISE_PROJECT_ID_TEST),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_limit"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_limit"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_registry_addresses.0.private"),
+					resource.TestCheckResourceAttrSet(resourceName, "config_center_addresses.0.private"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"admin_pass",
+					"extend_params",
+				},
+				ImportStateIdFunc: testAccEngineResourceImportStateFunc(resourceName),
+			},
+		},
+	})
+}
+
+// With enterprise project ID
+func testAccEngineResourceImportStateFunc(rName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", rName, rs)
+		}
+		if rs.Primary.Attributes["enterprise_project_id"] == "" {
+			return "", fmt.Errorf("The imported ID specifies an invalid format, want '{id}/{enterprise_project_id}', "+
+				"but '%s/%s'", rs.Primary.ID, rs.Primary.Attributes["enterprise_project_id"])
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.ID, rs.Primary.Attributes["enterprise_project_id"]), nil
+	}
+}
+
+func testAccMicroserviceEngine_withEpsId(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_cse_microservice_engine" "test" {
+  name                  = "%[2]s"
+  description           = "Created by terraform test"
+  flavor                = "cse.s1.small2"
+  network_id            = hcs_vpc_subnet.test.id
+  eip_id                = hcs_vpc_eip.test.id
+  enterprise_project_id = "%[3]s"
+
+  auth_type  = "RBAC"
+  admin_pass = "AccTest!123"
+
+  availability_zones = slice(data.hcs_availability_zones.test.names, 0, 1)
+
+}`, testAccMicroserviceEngine_base(name), name, acceptance.HCS_ENTERPRISE_PROJECT_ID_TEST)
+}
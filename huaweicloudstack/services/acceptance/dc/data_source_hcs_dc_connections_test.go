@@ -0,0 +1,52 @@
+package dc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccDirectConnectionsDataSource_basic(t *testing.T) {
+	var (
+		all    = "data.hcs_dc_connections.all"
+		byId   = "data.hcs_dc_connections.filter_by_id"
+		dcAll  = acceptance.InitDataSourceCheck(all)
+		dcById = acceptance.InitDataSourceCheck(byId)
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDcDirectConnection(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectConnectionsDataSource_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					dcAll.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(all, "connections.#"),
+					dcById.CheckResourceExists(),
+					resource.TestCheckResourceAttr(byId, "connections.#", "1"),
+					resource.TestCheckResourceAttr(byId, "connections.0.id", acceptance.HCS_DC_DIRECT_CONNECT_ID),
+					resource.TestCheckResourceAttrSet(byId, "connections.0.name"),
+					resource.TestCheckResourceAttrSet(byId, "connections.0.bandwidth"),
+					resource.TestCheckResourceAttrSet(byId, "connections.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectConnectionsDataSource_basic() string {
+	return fmt.Sprintf(`
+data "hcs_dc_connections" "all" {}
+
+data "hcs_dc_connections" "filter_by_id" {
+  connection_id = "%s"
+}
+`, acceptance.HCS_DC_DIRECT_CONNECT_ID)
+}
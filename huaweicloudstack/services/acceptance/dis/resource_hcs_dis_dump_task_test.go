@@ -0,0 +1,229 @@
+package dis
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dis/v2/dumptasks"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getDisDumpTaskResourceFunc(conf *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.DisV2Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DIS v2 client, err: %s", err)
+	}
+
+	return dumptasks.Get(client, state.Primary.Attributes["stream_name"], state.Primary.Attributes["task_name"])
+}
+
+func TestAccResourceDisDumpTask_obs(t *testing.T) {
+	var task dumptasks.DumpTask
+	resourceName := "hcs_dis_dump_task.test"
+	name := acceptance.RandomAccResourceNameWithDash()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&task,
+		getDisDumpTaskResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDisDumpTask(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDisDumpTask_obs(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "stream_name", "hcs_dis_stream.test", "stream_name"),
+					resource.TestCheckResourceAttr(resourceName, "task_name", name),
+					resource.TestCheckResourceAttr(resourceName, "destination_type", "OBS"),
+					resource.TestCheckResourceAttr(resourceName, "obs_destination.0.agency_name",
+						acceptance.HCS_DIS_DUMP_TASK_AGENCY),
+					resource.TestCheckResourceAttrPair(resourceName, "obs_destination.0.obs_bucket_path",
+						"hcs_obs_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "obs_destination.0.deliver_time_interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "obs_destination.0.file_prefix", "dis"),
+					resource.TestCheckResourceAttr(resourceName, "obs_destination.0.partition_format", "yyyy/MM/dd"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDisDumpTaskImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccResourceDisDumpTask_dws(t *testing.T) {
+	var task dumptasks.DumpTask
+	resourceName := "hcs_dis_dump_task.test"
+	name := acceptance.RandomAccResourceNameWithDash()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&task,
+		getDisDumpTaskResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDisDumpTask(t)
+			acceptance.TestAccPreCheckDisDumpTaskDws(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDisDumpTask_dws(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "destination_type", "DWS"),
+					resource.TestCheckResourceAttr(resourceName, "dws_destination.0.cluster_id",
+						acceptance.HCS_DIS_DWS_CLUSTER_ID),
+					resource.TestCheckResourceAttr(resourceName, "dws_destination.0.table_name",
+						acceptance.HCS_DIS_DWS_TABLE_NAME),
+					resource.TestCheckResourceAttr(resourceName, "dws_destination.0.retry_duration", "0"),
+				),
+			},
+			{
+				// The explicit zero retry duration must not cause a replacement.
+				Config:   testAccDisDumpTask_dws(name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceDisDumpTask_destinationMismatch(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDisDumpTask_destinationMismatch(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("dws_destination is required when destination_type is DWS"),
+			},
+		},
+	})
+}
+
+func testAccDisDumpTaskImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", resourceName, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["stream_name"], rs.Primary.Attributes["task_name"]), nil
+	}
+}
+
+func testAccDisDumpTask_obs(name string) string {
+	return fmt.Sprintf(`
+resource "hcs_dis_stream" "test" {
+  stream_name     = "%[1]s"
+  partition_count = 1
+}
+
+resource "hcs_obs_bucket" "test" {
+  bucket        = "%[1]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "hcs_dis_dump_task" "test" {
+  stream_name      = hcs_dis_stream.test.stream_name
+  task_name        = "%[1]s"
+  destination_type = "OBS"
+
+  obs_destination {
+    agency_name           = "%[2]s"
+    obs_bucket_path       = hcs_obs_bucket.test.bucket
+    deliver_time_interval = 30
+    consumer_strategy     = "LATEST"
+    file_prefix           = "dis"
+    partition_format      = "yyyy/MM/dd"
+    destination_file_type = "text"
+  }
+}
+`, name, acceptance.HCS_DIS_DUMP_TASK_AGENCY)
+}
+
+func testAccDisDumpTask_dws(name string) string {
+	return fmt.Sprintf(`
+resource "hcs_dis_stream" "test" {
+  stream_name     = "%[1]s"
+  partition_count = 1
+}
+
+resource "hcs_obs_bucket" "test" {
+  bucket        = "%[1]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "hcs_kms_key" "test" {
+  key_alias    = "%[1]s"
+  pending_days = "7"
+}
+
+resource "hcs_dis_dump_task" "test" {
+  stream_name      = hcs_dis_stream.test.stream_name
+  task_name        = "%[1]s"
+  destination_type = "DWS"
+
+  dws_destination {
+    agency_name           = "%[2]s"
+    deliver_time_interval = 30
+    cluster_name          = "%[3]s"
+    cluster_id            = "%[4]s"
+    database_name         = "gaussdb"
+    schema                = "public"
+    table_name            = "%[5]s"
+    delimiter             = ","
+    user_name             = "dbadmin"
+    user_password         = "%[6]s"
+    kms_user_key_name     = hcs_kms_key.test.key_alias
+    kms_user_key_id       = hcs_kms_key.test.id
+    obs_bucket_path       = hcs_obs_bucket.test.bucket
+    retry_duration        = 0
+  }
+}
+`, name, acceptance.HCS_DIS_DUMP_TASK_AGENCY, acceptance.HCS_DIS_DWS_CLUSTER_NAME, acceptance.HCS_DIS_DWS_CLUSTER_ID,
+		acceptance.HCS_DIS_DWS_TABLE_NAME, acceptance.HCS_DIS_DWS_PASSWORD)
+}
+
+func testAccDisDumpTask_destinationMismatch(name string) string {
+	return fmt.Sprintf(`
+resource "hcs_dis_dump_task" "test" {
+  stream_name      = "%[1]s"
+  task_name        = "%[1]s"
+  destination_type = "DWS"
+
+  obs_destination {
+    agency_name           = "dis_admin_agency"
+    obs_bucket_path       = "%[1]s"
+    deliver_time_interval = 30
+  }
+}
+`, name)
+}
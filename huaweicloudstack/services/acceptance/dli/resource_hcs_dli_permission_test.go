@@ -0,0 +1,282 @@
+package dli
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dli/v1/auth"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/dli"
+)
+
+func getDliAuthResourceFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DliV1Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Dli v1 client, err=%s", err)
+	}
+	obj, userName := dli.ParseAuthInfoFromId(state.Primary.ID)
+
+	permission, pErr := dli.QueryPermission(client, obj, userName)
+	if pErr != nil {
+		return nil, fmt.Errorf("this resource is not exist. Id=%s", state.Primary.ID)
+	}
+
+	return permission, nil
+}
+
+// test database permissions
+func TestAccResourceDliAuth_basic(t *testing.T) {
+	var obj auth.Privilege
+	resourceName := "hcs_dli_permission.test"
+	name := acceptance.RandomAccResourceName()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDliAuthResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDliUserName(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDliAuthResource_basic(name, "SELECT"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "user_name", acceptance.HCS_DLI_USER_NAME),
+					resource.TestCheckResourceAttr(resourceName, "object", fmt.Sprintf("databases.%s", name)),
+					resource.TestCheckResourceAttr(resourceName, "privileges.0", "SELECT"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_admin"),
+				),
+			},
+			{
+				Config: testAccDliAuthResource_basic(name, "CREATE_TABLE"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "user_name", acceptance.HCS_DLI_USER_NAME),
+					resource.TestCheckResourceAttr(resourceName, "object", fmt.Sprintf("databases.%s", name)),
+					resource.TestCheckResourceAttr(resourceName, "privileges.0", "CREATE_TABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_admin"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDliAuthResource_basic(name, privileges string) string {
+	database := testAccDliDatabase_basic(name)
+
+	return fmt.Sprintf(`
+%s
+
+resource "hcs_dli_permission" "test" {
+  user_name  = "%s"
+  object     = "databases.${hcs_dli_database.test.name}"
+  privileges = ["%s"]
+}
+`, database, acceptance.HCS_DLI_USER_NAME, privileges)
+}
+
+func TestAccResourceDliAuth_flink(t *testing.T) {
+	var obj auth.Privilege
+	resourceName := "hcs_dli_permission.test"
+	name := acceptance.RandomAccResourceName()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDliAuthResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDliUserName(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDliAuthResource_flink(name, acceptance.HCS_REGION_NAME, "GET"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "user_name", acceptance.HCS_DLI_USER_NAME),
+					resource.TestMatchResourceAttr(resourceName, "object", regexp.MustCompile(`jobs\.flink\.\d*`)),
+					resource.TestCheckResourceAttr(resourceName, "privileges.0", "GET"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_admin"),
+				),
+			},
+			{
+				Config: testAccDliAuthResource_flink(name, acceptance.HCS_REGION_NAME, "START"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "user_name", acceptance.HCS_DLI_USER_NAME),
+					resource.TestMatchResourceAttr(resourceName, "object", regexp.MustCompile(`jobs\.flink\.\d*`)),
+					resource.TestCheckResourceAttr(resourceName, "privileges.0", "START"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_admin"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDliAuthResource_flink(name, region, privileges string) string {
+	flinkJob := testAccFlinkJobResource_basic(name, region)
+
+	return fmt.Sprintf(`
+%s
+
+resource "hcs_dli_permission" "test" {
+  user_name  = "%s"
+  object     = "jobs.flink.${hcs_dli_flinksql_job.test.id}"
+  privileges = ["%s"]
+}
+`, flinkJob, acceptance.HCS_DLI_USER_NAME, privileges)
+}
+
+func TestAccResourceDliAuth_table(t *testing.T) {
+	var obj auth.Privilege
+	resourceName := "hcs_dli_permission.test"
+	name := acceptance.RandomAccResourceName()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDliAuthResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDliUserName(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDliAuthResource_table(name, "SELECT"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "user_name", acceptance.HCS_DLI_USER_NAME),
+					resource.TestCheckResourceAttr(resourceName, "object",
+						fmt.Sprintf("databases.%s.tables.%s", name, name)),
+					resource.TestCheckResourceAttr(resourceName, "privileges.0", "SELECT"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_admin"),
+				),
+			},
+			{
+				Config: testAccDliAuthResource_table(name, "DROP_TABLE"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "user_name", acceptance.HCS_DLI_USER_NAME),
+					resource.TestCheckResourceAttr(resourceName, "object",
+						fmt.Sprintf("databases.%s.tables.%s", name, name)),
+					resource.TestCheckResourceAttr(resourceName, "privileges.0", "DROP_TABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_admin"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDliAuthResource_table(name, privileges string) string {
+	table := testAccDliTableResource_basic(name)
+
+	return fmt.Sprintf(`
+%s
+
+resource "hcs_dli_permission" "test" {
+  user_name  = "%s"
+  object     = "databases.${hcs_dli_database.test.name}.tables.${hcs_dli_table.test.name}"
+  privileges = ["%s"]
+}
+`, table, acceptance.HCS_DLI_USER_NAME, privileges)
+}
+
+func TestAccResourceDliAuth_queue(t *testing.T) {
+	var obj auth.Privilege
+	resourceName := "hcs_dli_permission.test"
+	name := acceptance.RandomAccResourceName()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDliAuthResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDliUserName(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDliAuthResource_queue(name, "DROP_QUEUE"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "user_name", acceptance.HCS_DLI_USER_NAME),
+					resource.TestCheckResourceAttr(resourceName, "object", fmt.Sprintf("queues.%s", name)),
+					resource.TestCheckResourceAttr(resourceName, "privileges.0", "DROP_QUEUE"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_admin"),
+				),
+			},
+			{
+				Config: testAccDliAuthResource_queue(name, "SUBMIT_JOB"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "user_name", acceptance.HCS_DLI_USER_NAME),
+					resource.TestCheckResourceAttr(resourceName, "object", fmt.Sprintf("queues.%s", name)),
+					resource.TestCheckResourceAttr(resourceName, "privileges.0", "SUBMIT_JOB"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_admin"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDliAuthResource_queue(name, privileges string) string {
+	queue := testAccDliQueue_basic(name, dli.CU16)
+
+	return fmt.Sprintf(`
+%s
+
+resource "hcs_dli_permission" "test" {
+  user_name  = "%s"
+  object     = "queues.${hcs_dli_queue.test.name}"
+  privileges = ["%s"]
+}
+`, queue, acceptance.HCS_DLI_USER_NAME, privileges)
+}
@@ -0,0 +1,115 @@
+package dli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dli/v2/batches"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getSparkJobResourceFunc(conf *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.DliV2Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DLI v2 client: %s", err)
+	}
+	return batches.Get(c, state.Primary.ID)
+}
+
+func TestAccDliSparkJobV2_basic(t *testing.T) {
+	var job batches.CreateResp
+
+	rName := acceptance.RandomAccResourceName()
+	dashName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "hcs_dli_spark_job.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&job,
+		getSparkJobResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDliSparkJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDliSparkJob_basic(rName, dashName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					acceptance.TestCheckResourceAttrWithVariable(resourceName, "queue_name",
+						"${hcs_dli_queue.test.name}"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDliSparkJobDestroy(s *terraform.State) error {
+	cfg := acceptance.TestAccProvider.Meta().(*config.HcsConfig)
+	client, err := cfg.DliV2Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating Dli v2 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "hcs_dli_spark_job" {
+			continue
+		}
+
+		resp, err := batches.GetState(client, rs.Primary.ID)
+		// If the status of the spark job is "dead" or "success", it means that the life cycle of the job has ended.
+		if err == nil && resp != nil && (resp.State != batches.StateDead && resp.State != batches.StateSuccess) {
+			return fmt.Errorf("spark job (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccDliSparkJob_basic(name, dashName string) string {
+	return fmt.Sprintf(`
+resource "hcs_dli_queue" "test" {
+  name       = "%[1]s"
+  cu_count   = 16
+  queue_type = "general"
+}
+
+resource "hcs_obs_bucket" "test" {
+  bucket        = "%[2]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "hcs_obs_bucket_object" "test" {
+  bucket       = hcs_obs_bucket.test.bucket
+  key          = "dli/packages/simple_pyspark_test.py"
+  content_type = "text/x-python"
+  content      = <<EOF
+#!/usr/bin/env python
+# _*_ coding: utf-8 _*_
+
+from pyspark.sql import SparkSession
+
+sparkSession = SparkSession.builder.appName("simple pyspark test").getOrCreate()
+sparkSession.sparkContext.parallelize(range(10)).count()
+sparkSession.stop()
+EOF
+}
+
+resource "hcs_dli_spark_job" "test" {
+  queue_name = hcs_dli_queue.test.name
+  name       = "%[1]s"
+  app_name   = "obs://${hcs_obs_bucket.test.bucket}/${hcs_obs_bucket_object.test.key}"
+}
+`, name, dashName)
+}
@@ -125,6 +125,64 @@ func TestAccComputeInstance_withEPS(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_network(t *testing.T) {
+	var (
+		instance   cloudservers.CloudServer
+		instanceId string
+	)
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "hcs_ecs_compute_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_network(rName, 1, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(resourceName, &instance),
+					testAccCheckComputeInstanceNotRecreated(resourceName, &instanceId),
+					resource.TestCheckResourceAttr(resourceName, "network.#", "1"),
+				),
+			},
+			{
+				Config: testAccComputeInstance_network(rName, 2, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(resourceName, &instance),
+					testAccCheckComputeInstanceNotRecreated(resourceName, &instanceId),
+					resource.TestCheckResourceAttr(resourceName, "network.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "network.1.port"),
+					resource.TestCheckResourceAttr(resourceName, "network.1.source_dest_check", "false"),
+					resource.TestCheckResourceAttr(resourceName, "network.1.security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "network.1.security_group_ids.*",
+						"hcs_networking_secgroup.test", "id"),
+				),
+			},
+			{
+				Config: testAccComputeInstance_network(rName, 2, "update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(resourceName, &instance),
+					testAccCheckComputeInstanceNotRecreated(resourceName, &instanceId),
+					resource.TestCheckResourceAttr(resourceName, "network.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "network.1.security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "network.1.security_group_ids.*",
+						"hcs_networking_secgroup.update", "id"),
+				),
+			},
+			{
+				Config: testAccComputeInstance_network(rName, 1, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(resourceName, &instance),
+					testAccCheckComputeInstanceNotRecreated(resourceName, &instanceId),
+					resource.TestCheckResourceAttr(resourceName, "network.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeInstanceDestroy(s *terraform.State) error {
 	cfg := config.GetHcsConfig(acceptance.TestAccProvider.Meta())
 	computeClient, err := cfg.ComputeV1Client(acceptance.HCS_REGION_NAME)
@@ -180,6 +238,24 @@ func testAccCheckComputeInstanceExists(n string, instance *cloudservers.CloudSer
 	}
 }
 
+// testAccCheckComputeInstanceNotRecreated records the instance ID in the first step and checks that the instance is
+// updated in place in the subsequent steps.
+func testAccCheckComputeInstanceNotRecreated(n string, instanceId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if *instanceId == "" {
+			*instanceId = rs.Primary.ID
+		} else if *instanceId != rs.Primary.ID {
+			return fmt.Errorf("instance is recreated, the ID changed from %s to %s", *instanceId, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 const testAccCompute_data = `
 data "hcs_availability_zones" "test" {}
 
@@ -328,3 +404,46 @@ resource "hcs_ecs_compute_instance" "test" {
 }
 `, testAccCompute_data, rName, epsID)
 }
+
+// testAccComputeInstance_network appends (nicCount - 1) NICs which use the security group named secgroup.
+func testAccComputeInstance_network(rName string, nicCount int, secgroup string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_networking_secgroup" "test" {
+  name = "%[2]s"
+}
+
+resource "hcs_networking_secgroup" "update" {
+  name = "%[2]s-update"
+}
+
+resource "hcs_ecs_compute_instance" "test" {
+  name               = "%[2]s"
+  image_id           = data.hcs_ims_images.test.images[0].id
+  flavor_id          = data.hcs_ecs_compute_flavors.test.ids[0]
+  security_group_ids = [data.hcs_networking_secgroups.test.security_groups[0].id]
+  availability_zone  = data.hcs_availability_zones.test.names[0]
+
+  network {
+    uuid = data.hcs_vpc_subnets.test.subnets[0].id
+  }
+
+  dynamic "network" {
+    for_each = range(%[3]d - 1)
+
+    content {
+      uuid               = data.hcs_vpc_subnets.test.subnets[0].id
+      source_dest_check  = false
+      security_group_ids = [hcs_networking_secgroup.%[4]s.id]
+    }
+  }
+
+  system_disk_type = "business_type_01"
+  system_disk_size = 10
+
+  delete_disks_on_termination = true
+  delete_eip_on_termination   = true
+}
+`, testAccCompute_data, rName, nicCount, secgroup)
+}
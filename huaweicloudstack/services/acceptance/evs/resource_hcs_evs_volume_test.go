@@ -169,3 +169,64 @@ resource "hcs_evs_volume" "test" {
 }
 `, testAccEvsVolume_base(), rName, updateSize)
 }
+
+func TestAccEvsVolume_retype(t *testing.T) {
+	var volume volumes.Volume
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "hcs_evs_volume.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&volume,
+		getVolumeResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckEvsRetype(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvsVolume_retype(rName, volumeType, false),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", volumeType),
+					resource.TestCheckResourceAttr(resourceName, "bootable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "readonly", "false"),
+				),
+			},
+			{
+				Config: testAccEvsVolume_retype(rName, acceptance.HCS_EVS_RETYPE_VOLUME_TYPE, true),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", acceptance.HCS_EVS_RETYPE_VOLUME_TYPE),
+					resource.TestCheckResourceAttr(resourceName, "bootable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "readonly", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEvsVolume_retype(rName, volumeType string, flag bool) string {
+	return fmt.Sprintf(`
+data "hcs_availability_zones" "test" {}
+
+resource "hcs_evs_volume" "test" {
+  availability_zone = data.hcs_availability_zones.test.names[0]
+  name              = "%[1]s"
+  size              = %[2]s
+  volume_type       = "%[3]s"
+  bootable          = %[4]t
+  readonly          = %[4]t
+}
+`, rName, size, volumeType, flag)
+}
@@ -0,0 +1,81 @@
+package evs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/blockstorage/v2/volumes"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccEvsVolumeTransferAccept_basic(t *testing.T) {
+	var volume volumes.Volume
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "hcs_evs_volume_transfer_accept.test"
+
+	rc := acceptance.InitResourceCheck(
+		"hcs_evs_volume.test",
+		&volume,
+		getVolumeResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDestProject(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				// The volume is transferred to the destination project and then back to the current project, so that
+				// it can be destroyed in the current project.
+				Config: testAccEvsVolumeTransferAccept_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_id", "hcs_evs_volume.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transfer_id",
+						"hcs_evs_volume_transfer.test", "id"),
+					resource.TestCheckResourceAttrPair("hcs_evs_volume_transfer_accept.back", "volume_id",
+						"hcs_evs_volume.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEvsVolumeTransferAccept_basic(rName string) string {
+	return fmt.Sprintf(`
+provider "hcs" {
+  alias = "dest"
+
+  region       = "%[3]s"
+  project_id   = "%[4]s"
+  project_name = "%[5]s"
+}
+
+%[1]s
+
+resource "hcs_evs_volume_transfer_accept" "test" {
+  provider = hcs.dest
+
+  transfer_id = hcs_evs_volume_transfer.test.id
+  auth_key    = hcs_evs_volume_transfer.test.auth_key
+}
+
+resource "hcs_evs_volume_transfer" "back" {
+  provider = hcs.dest
+
+  volume_id = hcs_evs_volume_transfer_accept.test.volume_id
+  name      = "%[2]s-back"
+}
+
+resource "hcs_evs_volume_transfer_accept" "back" {
+  transfer_id = hcs_evs_volume_transfer.back.id
+  auth_key    = hcs_evs_volume_transfer.back.auth_key
+}
+`, testAccEvsVolumeTransfer_basic(rName), rName, acceptance.HCS_REGION_NAME, acceptance.HCS_DEST_PROJECT_ID,
+		acceptance.HCS_DEST_PROJECT_NAME)
+}
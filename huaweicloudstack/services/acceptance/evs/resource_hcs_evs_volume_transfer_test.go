@@ -0,0 +1,77 @@
+package evs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/blockstorage/extensions/volumetransfers"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getVolumeTransferResourceFunc(conf *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.BlockStorageV2Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating block storage v2 client: %s", err)
+	}
+	return volumetransfers.Get(c, state.Primary.ID).Extract()
+}
+
+func TestAccEvsVolumeTransfer_basic(t *testing.T) {
+	var transfer volumetransfers.Transfer
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "hcs_evs_volume_transfer.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&transfer,
+		getVolumeTransferResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvsVolumeTransfer_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "volume_id", "hcs_evs_volume.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "auth_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"auth_key",
+				},
+			},
+		},
+	})
+}
+
+func testAccEvsVolumeTransfer_basic(rName string) string {
+	return fmt.Sprintf(`
+data "hcs_availability_zones" "test" {}
+
+resource "hcs_evs_volume" "test" {
+  availability_zone = data.hcs_availability_zones.test.names[0]
+  name              = "%[1]s"
+  size              = 10
+  volume_type       = "%[2]s"
+}
+
+resource "hcs_evs_volume_transfer" "test" {
+  volume_id = hcs_evs_volume.test.id
+  name      = "%[1]s"
+}
+`, rName, volumeType)
+}
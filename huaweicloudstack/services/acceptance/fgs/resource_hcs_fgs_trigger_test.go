@@ -0,0 +1,315 @@
+package fgs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/fgs/v2/trigger"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getTriggerResourceFunc(conf *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	c, err := conf.FgsV2Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating HuaweiCloudStack FunctionGraph v2 client: %s", err)
+	}
+	return trigger.Get(c, state.Primary.Attributes["function_urn"], state.Primary.Attributes["type"],
+		state.Primary.ID).Extract()
+}
+
+func TestAccFunctionGraphTrigger_basic(t *testing.T) {
+	var (
+		timeTrigger  trigger.Trigger
+		randName     = acceptance.RandomAccResourceName()
+		resourceName = "hcs_fgs_trigger.test"
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&timeTrigger,
+		getTriggerResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionGraphTimingTrigger_basic(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "type", "TIMER"),
+					resource.TestCheckResourceAttr(resourceName, "timer.0.name", randName),
+					resource.TestCheckResourceAttr(resourceName, "timer.0.schedule_type", "Rate"),
+					resource.TestCheckResourceAttr(resourceName, "timer.0.schedule", "3d"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					acceptance.TestCheckResourceAttrWithVariable(resourceName, "function_urn",
+						"${hcs_fgs_function.test.urn}"),
+				),
+			},
+			{
+				Config: testAccFunctionGraphTimingTrigger_update(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "type", "TIMER"),
+					resource.TestCheckResourceAttr(resourceName, "timer.0.name", randName),
+					resource.TestCheckResourceAttr(resourceName, "timer.0.schedule_type", "Rate"),
+					resource.TestCheckResourceAttr(resourceName, "timer.0.schedule", "3d"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DISABLED"),
+					acceptance.TestCheckResourceAttrWithVariable(resourceName, "function_urn",
+						"${hcs_fgs_function.test.urn}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFunctionGraphTrigger_cronTimer(t *testing.T) {
+	var (
+		randName     = acceptance.RandomAccResourceName()
+		resourceName = "hcs_fgs_trigger.test"
+		timeTrigger  trigger.Trigger
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&timeTrigger,
+		getTriggerResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionGraphTimingTrigger_cron(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "type", "TIMER"),
+					resource.TestCheckResourceAttr(resourceName, "timer.0.name", randName),
+					resource.TestCheckResourceAttr(resourceName, "timer.0.schedule_type", "Cron"),
+					resource.TestCheckResourceAttr(resourceName, "timer.0.schedule", "@every 1h30m"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					acceptance.TestCheckResourceAttrWithVariable(resourceName, "function_urn",
+						"${hcs_fgs_function.test.urn}"),
+				),
+			},
+			{
+				Config: testAccFunctionGraphTimingTrigger_cronUpdate(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "type", "TIMER"),
+					resource.TestCheckResourceAttr(resourceName, "timer.0.name", randName),
+					resource.TestCheckResourceAttr(resourceName, "timer.0.schedule_type", "Cron"),
+					resource.TestCheckResourceAttr(resourceName, "timer.0.schedule", "@every 1h30m"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DISABLED"),
+					acceptance.TestCheckResourceAttrWithVariable(resourceName, "function_urn",
+						"${hcs_fgs_function.test.urn}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFunctionGraphTrigger_smn(t *testing.T) {
+	var (
+		randName     = acceptance.RandomAccResourceName()
+		resourceName = "hcs_fgs_trigger.test"
+		timeTrigger  trigger.Trigger
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&timeTrigger,
+		getTriggerResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionGraphSmnTrigger_basic(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "type", "SMN"),
+					resource.TestCheckResourceAttrSet(resourceName, "smn.0.topic_urn"),
+					acceptance.TestCheckResourceAttrWithVariable(resourceName, "function_urn",
+						"${hcs_fgs_function.test.urn}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFunctionGraphTrigger_obs(t *testing.T) {
+	var (
+		randName     = acceptance.RandomAccResourceNameWithDash()
+		resourceName = "hcs_fgs_trigger.test"
+		obsTrigger   trigger.Trigger
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obsTrigger,
+		getTriggerResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionGraphObsTrigger_basic(randName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "type", "OBS"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(resourceName, "obs.0.bucket_name",
+						"hcs_obs_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "obs.0.event_notification_name", randName),
+					resource.TestCheckResourceAttr(resourceName, "obs.0.events.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "obs.0.prefix", "upload"),
+					resource.TestCheckResourceAttr(resourceName, "obs.0.suffix", ".json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFunctionGraphTimingTrigger_base(rName string) string {
+	//nolint:revive
+	return fmt.Sprintf(`
+resource "hcs_fgs_function" "test" {
+  name        = "%s"
+  app         = "default"
+  handler     = "index.handler"
+  memory_size = 128
+  timeout     = 10
+  runtime     = "Python2.7"
+  code_type   = "inline"
+  func_code   = "aW1wb3J0IGpzb24KZGVmIGhhbmRsZXIgKGZW50LCBjb250ZXh0KToKICAgIG91dHB1dCA9ICdIZWxsbyBtZXNzYWdlOiAnICsganNvbi5kdW1wcyhldmVudCkKICAgIHJldHVybiBvdXRwdXQ="
+}`, rName)
+}
+
+func testAccFunctionGraphTimingTrigger_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "hcs_fgs_trigger" "test" {
+  function_urn = hcs_fgs_function.test.urn
+  type         = "TIMER"
+
+  timer {
+    name          = "%s"
+    schedule_type = "Rate"
+    schedule      = "3d"
+  }
+}
+`, testAccFunctionGraphTimingTrigger_base(rName), rName)
+}
+
+func testAccFunctionGraphTimingTrigger_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "hcs_fgs_trigger" "test" {
+  function_urn = hcs_fgs_function.test.urn
+  type         = "TIMER"
+  status       = "DISABLED"
+
+  timer {
+	name          = "%s"
+	schedule_type = "Rate"
+	schedule      = "3d"
+  }
+}
+`, testAccFunctionGraphTimingTrigger_base(rName), rName)
+}
+
+func testAccFunctionGraphTimingTrigger_cron(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "hcs_fgs_trigger" "test" {
+  function_urn = hcs_fgs_function.test.urn
+  type         = "TIMER"
+
+  timer {
+    name          = "%s"
+    schedule_type = "Cron"
+    schedule      = "@every 1h30m"
+  }
+}
+`, testAccFunctionGraphTimingTrigger_base(rName), rName)
+}
+
+func testAccFunctionGraphTimingTrigger_cronUpdate(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "hcs_fgs_trigger" "test" {
+  function_urn = hcs_fgs_function.test.urn
+  type         = "TIMER"
+  status       = "DISABLED"
+
+  timer {
+	name          = "%s"
+	schedule_type = "Cron"
+	schedule      = "@every 1h30m"
+  }
+}
+`, testAccFunctionGraphTimingTrigger_base(rName), rName)
+}
+
+func testAccFunctionGraphSmnTrigger_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "hcs_smn_topic" "test" {
+  name = "%s"
+}
+
+resource "hcs_fgs_trigger" "test" {
+  function_urn = hcs_fgs_function.test.urn
+  type         = "SMN"
+
+  smn {
+    topic_urn = hcs_smn_topic.test.topic_urn
+  }
+}`, testAccFunctionGraphTimingTrigger_base(rName), rName)
+}
+
+func testAccFunctionGraphObsTrigger_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "hcs_obs_bucket" "test" {
+  bucket        = "%[2]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "hcs_fgs_trigger" "test" {
+  function_urn = hcs_fgs_function.test.urn
+  type         = "OBS"
+  status       = "ACTIVE"
+
+  obs {
+    bucket_name             = hcs_obs_bucket.test.bucket
+    event_notification_name = "%[2]s"
+    events                  = ["ObjectCreated"]
+    prefix                  = "upload"
+    suffix                  = ".json"
+  }
+}`, testAccFunctionGraphTimingTrigger_base(rName), rName)
+}
@@ -0,0 +1,94 @@
+package maas
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/maas/v1/task"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func getTaskResourceFunc(cfg *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.MaasV1Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating MAAS v1 client: %s", err)
+	}
+	return task.Get(client, state.Primary.ID).Extract()
+}
+
+func TestAccMaasTask_basic(t *testing.T) {
+	var (
+		obj task.TaskResult
+
+		rName      = "hcs_maas_task.test"
+		bucketName = fmt.Sprintf("tf-acc-test-maas-%s", acctest.RandString(5))
+	)
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getTaskResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckMaas(t)
+			acceptance.TestAccPreCheckMaasSource(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMaasTask_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "description", "migration task"),
+					resource.TestCheckResourceAttr(rName, "enable_kms", "false"),
+					resource.TestCheckResourceAttr(rName, "thread_num", "1"),
+					resource.TestCheckResourceAttr(rName, "status", "5"),
+					resource.TestCheckResourceAttrSet(rName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMaasTask_basic(bucketName string) string {
+	return fmt.Sprintf(`
+resource "hcs_obs_bucket" "test" {
+  bucket        = "%[1]s"
+  force_destroy = true
+}
+
+resource "hcs_maas_task" "test" {
+  description = "migration task"
+  enable_kms  = false
+  thread_num  = 1
+
+  src_node {
+    region     = "%[7]s"
+    ak         = "%[2]s"
+    sk         = "%[3]s"
+    object_key = "%[8]s"
+    bucket     = "%[9]s"
+  }
+
+  dst_node {
+    region     = "%[4]s"
+    ak         = "%[5]s"
+    sk         = "%[6]s"
+    object_key = "oms"
+    bucket     = hcs_obs_bucket.test.bucket
+  }
+}
+`, bucketName, acceptance.HCS_SRC_ACCESS_KEY, acceptance.HCS_SRC_SECRET_KEY, acceptance.HCS_REGION_NAME,
+		acceptance.HCS_ACCESS_KEY, acceptance.HCS_SECRET_KEY, acceptance.HCS_MAAS_SRC_REGION,
+		acceptance.HCS_MAAS_SRC_OBJECT_KEY, acceptance.HCS_MAAS_SRC_BUCKET)
+}
@@ -0,0 +1,54 @@
+package rms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+)
+
+func TestAccDataResourceCompliance_basic(t *testing.T) {
+	var (
+		dName = "data.hcs_rms_resource_compliance.test"
+		dc    = acceptance.InitDataSourceCheck(dName)
+		name  = acceptance.RandomAccResourceNameWithDash()
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPrecheckDomainId(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataResourceCompliance_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dName, "value.#", "1"),
+					resource.TestCheckResourceAttrPair(dName, "value.0.resource_id",
+						"hcs_ecs_compute_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(dName, "value.0.policy_assignment_id",
+						"hcs_rms_policy_assignment.test", "id"),
+					resource.TestCheckResourceAttr(dName, "value.0.resource_provider", "ecs"),
+					resource.TestCheckResourceAttr(dName, "value.0.resource_type", "cloudservers"),
+					resource.TestCheckResourceAttrSet(dName, "value.0.compliance_state"),
+					resource.TestCheckResourceAttrSet(dName, "value.0.evaluation_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataResourceCompliance_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "hcs_rms_resource_compliance" "test" {
+  policy_assignment_id = hcs_rms_policy_assignment.test.id
+  resource_id          = hcs_ecs_compute_instance.test.id
+}
+`, testAccPolicyAssignment_basic(testAccPolicyAssignment_ecsConfig(name), name, "Enabled"))
+}
@@ -0,0 +1,276 @@
+package workspace
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/workspace/v2/desktops"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/services/acceptance/common"
+)
+
+func getDesktopFunc(conf *config.HcsConfig, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.WorkspaceV2Client(acceptance.HCS_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating Workspace v2 client: %s", err)
+	}
+	return desktops.Get(client, state.Primary.ID)
+}
+
+func TestAccDesktop_basic(t *testing.T) {
+	var (
+		desktop      desktops.Desktop
+		resourceName = "hcs_workspace_desktop.test"
+		rName        = acceptance.RandomAccResourceNameWithDash()
+	)
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&desktop,
+		getDesktopFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDesktop_basic_step1(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "hcs_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone",
+						"data.hcs_availability_zones.test", "names.0"),
+					resource.TestCheckResourceAttr(resourceName, "flavor_id", "workspace.x86.ultimate.large2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "user_name", "user-"+rName),
+					resource.TestCheckResourceAttr(resourceName, "user_group", "administrators"),
+					resource.TestCheckResourceAttr(resourceName, "root_volume.0.type", "SAS"),
+					resource.TestCheckResourceAttr(resourceName, "root_volume.0.size", "80"),
+					resource.TestCheckResourceAttr(resourceName, "data_volume.0.type", "SAS"),
+					resource.TestCheckResourceAttr(resourceName, "data_volume.0.size", "50"),
+					resource.TestCheckResourceAttr(resourceName, "data_volume.1.type", "SAS"),
+					resource.TestCheckResourceAttr(resourceName, "data_volume.1.size", "70"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccDesktop_basic_step2(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "flavor_id", "workspace.x86.ultimate.large4"),
+					resource.TestCheckResourceAttr(resourceName, "root_volume.0.type", "SAS"),
+					resource.TestCheckResourceAttr(resourceName, "root_volume.0.size", "100"),
+					resource.TestCheckResourceAttr(resourceName, "data_volume.0.type", "SAS"),
+					resource.TestCheckResourceAttr(resourceName, "data_volume.0.size", "50"),
+					resource.TestCheckResourceAttr(resourceName, "data_volume.1.type", "SAS"),
+					resource.TestCheckResourceAttr(resourceName, "data_volume.1.size", "90"),
+					resource.TestCheckResourceAttr(resourceName, "data_volume.2.type", "SAS"),
+					resource.TestCheckResourceAttr(resourceName, "data_volume.2.size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "data_volume.3.type", "SAS"),
+					resource.TestCheckResourceAttr(resourceName, "data_volume.3.size", "40"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baar"),
+				),
+			},
+			{
+				Config: testAccDesktop_basic_step3(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "power_action", "os-stop"),
+					resource.TestCheckResourceAttr(resourceName, "status", "SHUTOFF"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"delete_user",
+					"image_type",
+					"nic",
+					"power_action",
+					"power_action_type",
+					"user_email",
+					"vpc_id",
+				},
+			},
+		},
+	})
+}
+
+func testAccDesktop_base(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "hcs_availability_zones" "test" {}
+
+resource "hcs_workspace_service" "test" {
+  access_mode = "INTERNET"
+  vpc_id      = hcs_vpc.test.id
+  network_ids = [
+    hcs_vpc_subnet.test.id,
+  ]
+}
+`, common.TestBaseNetwork(rName))
+}
+
+func testAccDesktop_basic_step1(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+locals {
+  data_volume_sizes = [50, 70]
+}
+
+resource "hcs_workspace_desktop" "test" {
+  flavor_id         = "workspace.x86.ultimate.large2"
+  image_type        = "market"
+  image_id          = "8451dedf-b353-43aa-b5fb-5bccadda2207"
+  availability_zone = data.hcs_availability_zones.test.names[0]
+  vpc_id            = hcs_vpc.test.id
+  security_groups   = [
+    hcs_workspace_service.test.desktop_security_group.0.id,
+    hcs_networking_secgroup.test.id,
+  ]
+
+  nic {
+    network_id = hcs_vpc_subnet.test.id
+  }
+
+  name       = "%[2]s"
+  user_name  = "user-%[2]s"
+  user_email = "terraform@example.com"
+  user_group = "administrators"
+
+  root_volume {
+    type = "SAS"
+    size = 80
+  }
+
+  dynamic "data_volume" {
+    for_each = local.data_volume_sizes
+
+    content {
+      type = "SAS"
+      size = data_volume.value
+    }
+  }
+
+  tags = {
+    foo = "bar"
+  }
+
+  delete_user = true
+}
+`, testAccDesktop_base(rName), rName)
+}
+
+func testAccDesktop_basic_step2(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+locals {
+  data_volume_sizes = [50, 90, 20, 40]
+}
+
+resource "hcs_workspace_desktop" "test" {
+  flavor_id         = "workspace.x86.ultimate.large4"
+  image_type        = "market"
+  image_id          = "8451dedf-b353-43aa-b5fb-5bccadda2207"
+  availability_zone = data.hcs_availability_zones.test.names[0]
+  vpc_id            = hcs_vpc.test.id
+  security_groups   = [
+    hcs_workspace_service.test.desktop_security_group.0.id,
+    hcs_networking_secgroup.test.id,
+  ]
+
+  nic {
+    network_id = hcs_vpc_subnet.test.id
+  }
+
+  name       = "%[2]s"
+  user_name  = "user-%[2]s"
+  user_email = "terraform@example.com"
+  user_group = "administrators"
+
+  root_volume {
+    type = "SAS"
+    size = 100
+  }
+
+  dynamic "data_volume" {
+    for_each = local.data_volume_sizes
+
+    content {
+      type = "SAS"
+      size = data_volume.value
+    }
+  }
+
+  tags = {
+    foo = "baar"
+  }
+
+  delete_user = true
+}
+`, testAccDesktop_base(rName), rName)
+}
+
+func testAccDesktop_basic_step3(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+locals {
+  data_volume_sizes = [50, 90, 20, 40]
+}
+
+resource "hcs_workspace_desktop" "test" {
+  flavor_id         = "workspace.x86.ultimate.large4"
+  image_type        = "market"
+  image_id          = "4d698843-d653-4ffd-80be-4f47a0cabce0"
+  availability_zone = data.hcs_availability_zones.test.names[0]
+  vpc_id            = hcs_vpc.test.id
+  security_groups   = [
+    hcs_workspace_service.test.desktop_security_group.0.id,
+    hcs_networking_secgroup.test.id,
+  ]
+
+  nic {
+    network_id = hcs_vpc_subnet.test.id
+  }
+
+  name       = "%[2]s"
+  user_name  = "user-%[2]s"
+  user_email = "terraform@example.com"
+  user_group = "administrators"
+
+  root_volume {
+    type = "SAS"
+    size = 100
+  }
+
+  dynamic "data_volume" {
+    for_each = local.data_volume_sizes
+
+    content {
+      type = "SAS"
+      size = data_volume.value
+    }
+  }
+
+  tags = {
+    foo = "baar"
+  }
+
+  power_action      = "os-stop"
+  power_action_type = "SOFT"
+  delete_user       = true
+}
+`, testAccDesktop_base(rName), rName)
+}
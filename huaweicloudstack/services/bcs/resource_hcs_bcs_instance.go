@@ -0,0 +1,742 @@
+package bcs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/bcs/v2/blockchains"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/cce/v3/clusters"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dms/v2/kafka/instances"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+func ResourceInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceInstanceCreate,
+		ReadContext:   resourceInstanceRead,
+		UpdateContext: resourceInstanceUpdate,
+		DeleteContext: resourceInstanceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"orderer_node_num": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"consensus": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"edition": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"fabric_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volume_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"org_disk_size": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"cce_cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"blockchain_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_mechanism": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"database_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"eip_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"peer_orgs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"org_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"count": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"pvc_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_detail": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"domain_port": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ip_port": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+				Set: resourcePeerOrgsHash,
+			},
+			"channels": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"org_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				Set: resourceChannelsHash,
+			},
+			"couchdb": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"sfs_turbo": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"flavor": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"share_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"block_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"transaction_quantity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"block_size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"generation_interval": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"kafka": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"flavor": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"storage_size": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tc3_need": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"restful_api_support": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"delete_obs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"delete_storage": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"cluster_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"purchase_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cross_region_support": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rollback_support": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"old_service_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"agent_portal_address": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceChannelsHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return hashcode.String(fmt.Sprintf("%s-", m["name"].(string)))
+}
+
+func resourcePeerOrgsHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return hashcode.String(fmt.Sprintf("%s-", m["org_name"].(string)))
+}
+
+func buildCCEClusterInfo(cfg *config.HcsConfig, region, clusterId string) (*blockchains.CCEClusterInfo, error) {
+	client, err := cfg.CceV3Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE v3 client: %s", err)
+	}
+	cluster, err := clusters.Get(client, clusterId).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving CCE cluster (%s): %s", clusterId, err)
+	}
+
+	return &blockchains.CCEClusterInfo{
+		ID:   clusterId,
+		Name: cluster.Metadata.Name,
+	}, nil
+}
+
+func buildPeerOrgs(orgs *schema.Set) []blockchains.PeerOrg {
+	result := make([]blockchains.PeerOrg, 0, orgs.Len())
+	for _, v := range orgs.List() {
+		org := v.(map[string]interface{})
+		result = append(result, blockchains.PeerOrg{
+			Name:      org["org_name"].(string),
+			NodeCount: org["count"].(int),
+		})
+	}
+	return result
+}
+
+func buildChannels(channels *schema.Set) []blockchains.ChannelInfo {
+	result := make([]blockchains.ChannelInfo, 0, channels.Len())
+	for _, v := range channels.List() {
+		channel := v.(map[string]interface{})
+		result = append(result, blockchains.ChannelInfo{
+			Name:     channel["name"].(string),
+			OrgNames: utils.ExpandToStringList(channel["org_names"].([]interface{})),
+		})
+	}
+	return result
+}
+
+func buildCouchDBInfo(infos []interface{}) *blockchains.CouchDBInfo {
+	if len(infos) < 1 {
+		return nil
+	}
+
+	info := infos[0].(map[string]interface{})
+	return &blockchains.CouchDBInfo{
+		UserName: info["user_name"].(string),
+		Password: info["password"].(string),
+	}
+}
+
+func buildSfsTurboInfo(infos []interface{}) *blockchains.SFSTurbo {
+	if len(infos) < 1 {
+		return nil
+	}
+
+	info := infos[0].(map[string]interface{})
+	return &blockchains.SFSTurbo{
+		ShareType:        info["share_type"].(string),
+		Type:             info["type"].(string),
+		AvailabilityZone: info["availability_zone"].(string),
+		Flavor:           info["flavor"].(string),
+	}
+}
+
+func buildBlockInfo(infos []interface{}) *blockchains.BlockInfo {
+	if len(infos) < 1 {
+		return nil
+	}
+
+	info := infos[0].(map[string]interface{})
+	return &blockchains.BlockInfo{
+		BatchTimeout:      info["generation_interval"].(int),
+		MaxMessageCount:   info["transaction_quantity"].(int),
+		PreferredMaxbytes: info["block_size"].(int),
+	}
+}
+
+func buildKafkaInfo(infos []interface{}) *blockchains.KafkaInfo {
+	if len(infos) < 1 {
+		return nil
+	}
+
+	info := infos[0].(map[string]interface{})
+	return &blockchains.KafkaInfo{
+		Flavor:           fmt.Sprintf("dms.instance.kafka.cluster.%s", info["flavor"].(string)),
+		Storage:          info["storage_size"].(int),
+		AvailabilityZone: strings.Join(utils.ExpandToStringList(info["availability_zone"].([]interface{})), ","),
+	}
+}
+
+func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.BcsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating BCS v2 client: %s", err)
+	}
+
+	clusterInfo, err := buildCCEClusterInfo(cfg, region, d.Get("cce_cluster_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createOpts := blockchains.CreateOpts{
+		Name:                d.Get("name").(string),
+		ClusterType:         "cce",
+		CreateNewCluster:    utils.Bool(false),
+		CCEClusterInfo:      clusterInfo,
+		EnterpriseProjectId: cfg.GetEnterpriseProjectID(d),
+		Password:            d.Get("password").(string),
+		VersionType:         d.Get("edition").(int),
+		FabricVersion:       d.Get("fabric_version").(string),
+		BlockChainType:      d.Get("blockchain_type").(string),
+		Consensus:           d.Get("consensus").(string),
+		EIPEnable:           d.Get("eip_enable").(bool),
+		SignAlgorithm:       d.Get("security_mechanism").(string),
+		VolumeType:          d.Get("volume_type").(string),
+		OrgDiskSize:         d.Get("org_disk_size").(int),
+		DatabaseType:        d.Get("database_type").(string),
+		OrdererNodeNumber:   d.Get("orderer_node_num").(int),
+		TC3Need:             d.Get("tc3_need").(bool),
+		RestfulAPISupport:   d.Get("restful_api_support").(bool),
+		PeerOrgs:            buildPeerOrgs(d.Get("peer_orgs").(*schema.Set)),
+		Channels:            buildChannels(d.Get("channels").(*schema.Set)),
+		CouchDBInfo:         buildCouchDBInfo(d.Get("couchdb").([]interface{})),
+		SFSTurbo:            buildSfsTurboInfo(d.Get("sfs_turbo").([]interface{})),
+		Block:               buildBlockInfo(d.Get("block_info").([]interface{})),
+		Kafka:               buildKafkaInfo(d.Get("kafka").([]interface{})),
+	}
+
+	resp, err := blockchains.Create(client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating BCS instance: %s", err)
+	}
+	d.SetId(resp.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"IsCreating"},
+		Target:       []string{"Normal"},
+		Refresh:      instanceStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        150 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for BCS instance (%s) to become normal: %s", d.Id(), err)
+	}
+
+	return resourceInstanceRead(ctx, d, meta)
+}
+
+func flattenPeerOrgs(peers []blockchains.Peer) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(peers))
+	for i, org := range peers {
+		addresses := make([]map[string]interface{}, len(org.Address))
+		for j, v := range org.Address {
+			addresses[j] = map[string]interface{}{
+				"domain_port": v.DomainPort,
+				"ip_port":     v.IPPort,
+			}
+		}
+		result[i] = map[string]interface{}{
+			"org_name":      org.Name,
+			"count":         org.NodeCount,
+			"status":        org.Status,
+			"status_detail": org.StatusDetail,
+			"pvc_name":      org.PVCName,
+			"address":       addresses,
+		}
+	}
+	return result
+}
+
+func flattenChannels(channels []blockchains.Channel) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(channels))
+	for i, v := range channels {
+		result[i] = map[string]interface{}{
+			"name":      v.Name,
+			"org_names": v.OrgNames,
+		}
+	}
+	return result
+}
+
+func flattenCouchDB(d *schema.ResourceData, couchDB blockchains.CouchDB) []map[string]interface{} {
+	if couchDB == (blockchains.CouchDB{}) {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"user_name": couchDB.User,
+			// The password is not returned by the API, so keep the value in the configuration.
+			"password": d.Get("couchdb.0.password"),
+		},
+	}
+}
+
+func flattenKafka(d *schema.ResourceData, instance *blockchains.BCSInstance) []map[string]interface{} {
+	if instance.Basic.Consensus != "kafka" {
+		return nil
+	}
+
+	// Only the name of the Kafka instance is returned by the API.
+	return []map[string]interface{}{
+		{
+			"name":              instance.DMSKafka.Name,
+			"flavor":            d.Get("kafka.0.flavor"),
+			"storage_size":      d.Get("kafka.0.storage_size"),
+			"availability_zone": d.Get("kafka.0.availability_zone"),
+		},
+	}
+}
+
+func resourceInstanceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.BcsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating BCS v2 client: %s", err)
+	}
+
+	instance, err := blockchains.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving BCS instance")
+	}
+	log.Printf("[DEBUG] Retrieved BCS instance (%s): %#v", d.Id(), instance)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", instance.Basic.Name),
+		d.Set("edition", instance.Basic.VersionType),
+		d.Set("blockchain_type", instance.Basic.ServiceType),
+		d.Set("consensus", instance.Basic.Consensus),
+		d.Set("security_mechanism", instance.Basic.SignAlgorithm),
+		d.Set("database_type", instance.Basic.DatabaseType),
+		d.Set("restful_api_support", instance.Basic.IsSupportRestful),
+		d.Set("tc3_need", instance.Basic.IsSupportTc3),
+		d.Set("cce_cluster_id", instance.Basic.ClusterID),
+		d.Set("cluster_type", instance.Basic.ClusterType),
+		d.Set("status", instance.Basic.Status),
+		d.Set("version", instance.Basic.Version),
+		d.Set("purchase_type", instance.Basic.PurchaseType),
+		d.Set("cross_region_support", instance.Basic.IsCrossRegion),
+		d.Set("rollback_support", instance.Basic.IsSupportRollback),
+		d.Set("old_service_version", instance.Basic.OldServiceVersion),
+		d.Set("agent_portal_address", instance.Basic.AgentPortalAddress),
+		d.Set("channels", flattenChannels(instance.Channels)),
+		d.Set("peer_orgs", flattenPeerOrgs(instance.Peer)),
+		d.Set("couchdb", flattenCouchDB(d, instance.CouchDB)),
+		d.Set("kafka", flattenKafka(d, instance)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting BCS instance fields: %s", err)
+	}
+	return nil
+}
+
+func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only the delete_obs and delete_storage can be updated, which are used in the delete operation.
+	return resourceInstanceRead(ctx, d, meta)
+}
+
+func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.BcsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating BCS v2 client: %s", err)
+	}
+
+	// The Kafka instance created by the BCS service should be released first.
+	if d.Get("consensus").(string) == "kafka" {
+		if err = deleteKafkaInstance(ctx, cfg, region, d.Get("kafka.0.name").(string),
+			d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	deleteOpts := blockchains.DeleteOpts{
+		IsDeleteOBS:     d.Get("delete_obs").(bool),
+		IsDeleteStorage: d.Get("delete_storage").(bool),
+	}
+	if err = blockchains.Delete(client, deleteOpts, d.Id()).Extract(); err != nil {
+		return diag.Errorf("error deleting BCS instance (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"IsDeleting"},
+		Target:       []string{"IsDeleted"},
+		Refresh:      instanceStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        15 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for BCS instance (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func instanceStateRefreshFunc(client *golangsdk.ServiceClient, instanceId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := blockchains.Get(client, instanceId).Extract()
+		if err != nil {
+			// The API returns 400 or 404 error if the instance does not exist.
+			if _, ok := err.(golangsdk.ErrDefault400); ok {
+				return instance, "IsDeleted", nil
+			}
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return instance, "IsDeleted", nil
+			}
+			return nil, "ERROR", err
+		}
+		if instance.Basic.ID == "" {
+			return instance, "IsDeleted", nil
+		}
+		if instance.Basic.ProcessStatus != "" {
+			return instance, instance.Basic.ProcessStatus, nil
+		}
+		return instance, instance.Basic.Status, nil
+	}
+}
+
+func deleteKafkaInstance(ctx context.Context, cfg *config.HcsConfig, region, kafkaName string,
+	timeout time.Duration) error {
+	client, err := cfg.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating DMS v2 client: %s", err)
+	}
+
+	listOpts := instances.ListOpts{
+		Engine: "kafka",
+		Name:   kafkaName,
+	}
+	pages, err := instances.List(client, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("error querying Kafka instance (%s): %s", kafkaName, err)
+	}
+	resp, err := instances.ExtractInstances(pages)
+	if err != nil {
+		return fmt.Errorf("error extracting Kafka instances: %s", err)
+	}
+	if len(resp.Instances) != 1 {
+		return fmt.Errorf("expected one Kafka instance named %s, but got %d", kafkaName, len(resp.Instances))
+	}
+
+	kafkaId := resp.Instances[0].InstanceID
+	if err = instances.Delete(client, kafkaId).ExtractErr(); err != nil {
+		return fmt.Errorf("error deleting Kafka instance (%s): %s", kafkaId, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"DELETING", "RUNNING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			instance, err := instances.Get(client, kafkaId).Extract()
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return instance, "DELETED", nil
+				}
+				return nil, "ERROR", err
+			}
+			return instance, instance.Status, nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for Kafka instance (%s) to be deleted: %s", kafkaId, err)
+	}
+	return nil
+}
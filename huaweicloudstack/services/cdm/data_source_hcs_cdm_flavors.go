@@ -0,0 +1,106 @@
+package cdm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/cdm/v1/flavors"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+)
+
+func DataSourceCdmFlavors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCdmFlavorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getCdmDatastoreId(client *golangsdk.ServiceClient) (string, error) {
+	datastores, err := flavors.ListDatastores(client)
+	if err != nil {
+		return "", fmt.Errorf("error querying CDM datastores: %s", err)
+	}
+
+	for _, ds := range datastores {
+		if ds.Name == "cdm" {
+			return ds.ID, nil
+		}
+	}
+	return "", fmt.Errorf("unable to find the CDM datastore")
+}
+
+func dataSourceCdmFlavorsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CdmV11Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CDM v1.1 client: %s", err)
+	}
+
+	datastoreId, err := getCdmDatastoreId(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	versions, err := flavors.List(client, datastoreId)
+	if err != nil {
+		return diag.Errorf("error querying CDM flavors: %s", err)
+	}
+	if len(versions) < 1 {
+		return diag.Errorf("unable to find any CDM flavors")
+	}
+
+	// Only the flavors of the first (current) version returned by the API are exported.
+	version := versions[0]
+	result := make([]map[string]interface{}, len(version.Flavors))
+	for i, flavor := range version.Flavors {
+		result[i] = map[string]interface{}{
+			"id":   flavor.ID,
+			"name": flavor.Name,
+		}
+	}
+
+	d.SetId(version.Name)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("version", version.Name),
+		d.Set("flavors", result),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving CDM flavors to state: %s", err)
+	}
+	return nil
+}
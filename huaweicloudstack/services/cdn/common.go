@@ -0,0 +1,113 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	cdnv1 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/cdn/v1"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/cdn/v1/model"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+// The status of the refresh and preload tasks.
+const (
+	taskStatusProcessing = "task_inprocess"
+	taskStatusDone       = "task_done"
+)
+
+// taskComputedSchema returns the attributes which are shared by the refresh and preload tasks.
+func taskComputedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"created_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"processing": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"succeed": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"failed": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"total": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func getHistoryTask(client *cdnv1.CdnClient, taskId, epsId string) (*model.ShowHistoryTaskDetailsResponse, error) {
+	request := model.ShowHistoryTaskDetailsRequest{
+		HistoryTasksId: taskId,
+	}
+	if epsId != "" {
+		request.EnterpriseProjectId = utils.String(epsId)
+	}
+	return client.ShowHistoryTaskDetails(&request)
+}
+
+func waitForHistoryTaskDone(ctx context.Context, client *cdnv1.CdnClient, taskId, epsId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{taskStatusProcessing},
+		Target:  []string{taskStatusDone},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := getHistoryTask(client, taskId, epsId)
+			if err != nil {
+				return nil, "", err
+			}
+			return resp, utils.StringValue(resp.Status), nil
+		},
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	resp, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for CDN task (%s) to complete: %s", taskId, err)
+	}
+
+	// The task is done even if some URLs are failed to be processed.
+	task := resp.(*model.ShowHistoryTaskDetailsResponse)
+	if task.Failed != nil && *task.Failed > 0 {
+		var total int32
+		if task.Total != nil {
+			total = *task.Total
+		}
+		return fmt.Errorf("the CDN task (%s) is done, but %d of %d URLs are failed to be processed", taskId,
+			*task.Failed, total)
+	}
+	return nil
+}
+
+func setHistoryTaskAttrs(d *schema.ResourceData, task *model.ShowHistoryTaskDetailsResponse) error {
+	var createdAt string
+	if task.CreateTime != nil {
+		createdAt = utils.FormatTimeStampRFC3339(*task.CreateTime/1000, false)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("status", task.Status),
+		d.Set("created_at", createdAt),
+		d.Set("processing", task.Processing),
+		d.Set("succeed", task.Succeed),
+		d.Set("failed", task.Failed),
+		d.Set("total", task.Total),
+	)
+	return mErr.ErrorOrNil()
+}
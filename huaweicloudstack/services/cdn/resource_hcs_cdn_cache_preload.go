@@ -0,0 +1,109 @@
+package cdn
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/cdn/v1/model"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+// ResourceCachePreload is an action resource, the preload task is submitted during the creation and it will only be
+// removed from the state during the deletion.
+func ResourceCachePreload() *schema.Resource {
+	taskSchema := map[string]*schema.Schema{
+		"urls": {
+			Type:     schema.TypeSet,
+			Required: true,
+			ForceNew: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"enterprise_project_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+	}
+	for k, v := range taskComputedSchema() {
+		taskSchema[k] = v
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceCachePreloadCreate,
+		ReadContext:   resourceCachePreloadRead,
+		DeleteContext: resourceCachePreloadDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: taskSchema,
+	}
+}
+
+func resourceCachePreloadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.HcCdnV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CDN v1 client: %s", err)
+	}
+
+	epsId := d.Get("enterprise_project_id").(string)
+	request := model.CreatePreheatingTasksRequest{
+		Body: &model.PreheatingTaskRequest{
+			PreheatingTask: &model.PreheatingTaskRequestBody{
+				Urls: utils.ExpandToStringListBySet(d.Get("urls").(*schema.Set)),
+			},
+		},
+	}
+	if epsId != "" {
+		request.EnterpriseProjectId = utils.String(epsId)
+	}
+	log.Printf("[DEBUG] The create options of the CDN cache preload task is: %#v", request)
+
+	resp, err := client.CreatePreheatingTasks(&request)
+	if err != nil {
+		return diag.Errorf("error creating CDN cache preload task: %s", err)
+	}
+	if resp.PreheatingTask == nil || *resp.PreheatingTask == "" {
+		return diag.Errorf("unable to find the task ID from the API response")
+	}
+	d.SetId(*resp.PreheatingTask)
+
+	if err = waitForHistoryTaskDone(ctx, client, d.Id(), epsId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCachePreloadRead(ctx, d, meta)
+}
+
+func resourceCachePreloadRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.HcCdnV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CDN v1 client: %s", err)
+	}
+
+	resp, err := getHistoryTask(client, d.Id(), d.Get("enterprise_project_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CDN cache preload task")
+	}
+
+	if err = setHistoryTaskAttrs(d, resp); err != nil {
+		return diag.Errorf("error setting CDN cache preload task fields: %s", err)
+	}
+	return nil
+}
+
+func resourceCachePreloadDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[WARN] The CDN cache preload task cannot be deleted, Terraform will only remove it from the state file.")
+	d.SetId("")
+	return nil
+}
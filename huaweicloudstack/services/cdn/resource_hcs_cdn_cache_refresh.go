@@ -0,0 +1,146 @@
+package cdn
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/cdn/v1/model"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+// ResourceCacheRefresh is an action resource, the refresh task is submitted during the creation and it will only be
+// removed from the state during the deletion.
+func ResourceCacheRefresh() *schema.Resource {
+	taskSchema := map[string]*schema.Schema{
+		"urls": {
+			Type:     schema.TypeSet,
+			Required: true,
+			ForceNew: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "file",
+			ValidateFunc: validation.StringInSlice([]string{
+				"file", "directory",
+			}, false),
+		},
+		"mode": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "all",
+			ValidateFunc: validation.StringInSlice([]string{
+				"all", "detect_modify_refresh",
+			}, false),
+		},
+		"enterprise_project_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+	}
+	for k, v := range taskComputedSchema() {
+		taskSchema[k] = v
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceCacheRefreshCreate,
+		ReadContext:   resourceCacheRefreshRead,
+		DeleteContext: resourceCacheRefreshDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: taskSchema,
+	}
+}
+
+func buildRefreshTaskType(taskType string) *model.RefreshTaskRequestBodyType {
+	typeEnum := model.GetRefreshTaskRequestBodyTypeEnum()
+	if taskType == "directory" {
+		return &typeEnum.DIRECTORY
+	}
+	return &typeEnum.FILE
+}
+
+func buildRefreshTaskMode(mode string) *model.RefreshTaskRequestBodyMode {
+	modeEnum := model.GetRefreshTaskRequestBodyModeEnum()
+	if mode == "detect_modify_refresh" {
+		return &modeEnum.DETECT_MODIFY_REFRESH
+	}
+	return &modeEnum.ALL
+}
+
+func resourceCacheRefreshCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.HcCdnV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CDN v1 client: %s", err)
+	}
+
+	epsId := d.Get("enterprise_project_id").(string)
+	request := model.CreateRefreshTasksRequest{
+		Body: &model.RefreshTaskRequest{
+			RefreshTask: &model.RefreshTaskRequestBody{
+				Type: buildRefreshTaskType(d.Get("type").(string)),
+				Mode: buildRefreshTaskMode(d.Get("mode").(string)),
+				Urls: utils.ExpandToStringListBySet(d.Get("urls").(*schema.Set)),
+			},
+		},
+	}
+	if epsId != "" {
+		request.EnterpriseProjectId = utils.String(epsId)
+	}
+	log.Printf("[DEBUG] The create options of the CDN cache refresh task is: %#v", request)
+
+	resp, err := client.CreateRefreshTasks(&request)
+	if err != nil {
+		return diag.Errorf("error creating CDN cache refresh task: %s", err)
+	}
+	if resp.RefreshTask == nil || *resp.RefreshTask == "" {
+		return diag.Errorf("unable to find the task ID from the API response")
+	}
+	d.SetId(*resp.RefreshTask)
+
+	if err = waitForHistoryTaskDone(ctx, client, d.Id(), epsId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCacheRefreshRead(ctx, d, meta)
+}
+
+func resourceCacheRefreshRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.HcCdnV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CDN v1 client: %s", err)
+	}
+
+	resp, err := getHistoryTask(client, d.Id(), d.Get("enterprise_project_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CDN cache refresh task")
+	}
+
+	if err = setHistoryTaskAttrs(d, resp); err != nil {
+		return diag.Errorf("error setting CDN cache refresh task fields: %s", err)
+	}
+	return nil
+}
+
+func resourceCacheRefreshDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[WARN] The CDN cache refresh task cannot be deleted, Terraform will only remove it from the state file.")
+	d.SetId("")
+	return nil
+}
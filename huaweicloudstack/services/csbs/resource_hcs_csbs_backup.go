@@ -0,0 +1,385 @@
+package csbs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/csbs/v1/backup"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+)
+
+// ResourceCSBSBackup is the impl of hcs_csbs_backup
+func ResourceCSBSBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCSBSBackupCreate,
+		ReadContext:   resourceCSBSBackupRead,
+		DeleteContext: resourceCSBSBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"backup_record_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"backup_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "OS::Nova::Server",
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_trigger": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"volume_backups": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"space_saving_ratio": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bootable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"average_speed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"source_volume_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"source_volume_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"incremental": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_volume_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vm_metadata": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"eip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cloud_service_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ram": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vcpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disk": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"image_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCSBSBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.CsbsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSBS v1 client: %s", err)
+	}
+
+	resourceId := d.Get("resource_id").(string)
+	resourceType := d.Get("resource_type").(string)
+	queryOpts := backup.ResourceBackupCapOpts{
+		CheckProtectable: []backup.ResourceCapQueryParams{
+			{
+				ResourceId:   resourceId,
+				ResourceType: resourceType,
+			},
+		},
+	}
+	query, err := backup.QueryResourceBackupCapability(client, queryOpts).ExtractQueryResponse()
+	if err != nil {
+		return diag.Errorf("error querying resource backup capability: %s", err)
+	}
+	if len(query) < 1 {
+		return diag.Errorf("unable to query the backup capability of the resource (%s)", resourceId)
+	}
+	if !query[0].Result {
+		return diag.Errorf("the resource (%s) cannot be backed up, error code: %s, error message: %s",
+			resourceId, query[0].ErrorCode, query[0].ErrorMsg)
+	}
+
+	createOpts := backup.CreateOpts{
+		BackupName:   d.Get("backup_name").(string),
+		Description:  d.Get("description").(string),
+		ResourceType: resourceType,
+	}
+	checkpoint, err := backup.Create(client, resourceId, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating CSBS backup: %s", err)
+	}
+
+	backupItems, err := backup.List(client, backup.ListOpts{CheckpointId: checkpoint.Id})
+	if err != nil {
+		return diag.Errorf("error querying CSBS backups: %s", err)
+	}
+	if len(backupItems) < 1 {
+		return diag.Errorf("unable to find the CSBS backup created by checkpoint (%s)", checkpoint.Id)
+	}
+	d.SetId(backupItems[0].Id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"protecting"},
+		Target:     []string{"available"},
+		Refresh:    backupStatusRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      3 * time.Minute,
+		MinTimeout: 3 * time.Minute,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for CSBS backup (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceCSBSBackupRead(ctx, d, meta)
+}
+
+func resourceCSBSBackupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CsbsV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSBS v1 client: %s", err)
+	}
+
+	backupObject, err := backup.Get(client, d.Id()).ExtractBackup()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "CSBS backup")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("resource_id", backupObject.ResourceId),
+		d.Set("backup_name", backupObject.Name),
+		d.Set("description", backupObject.Description),
+		d.Set("resource_type", backupObject.ResourceType),
+		d.Set("status", backupObject.Status),
+		d.Set("created_at", backupObject.CreatedAt.Format(time.RFC3339)),
+		d.Set("volume_backups", flattenCSBSVolumeBackups(backupObject)),
+		d.Set("vm_metadata", flattenCSBSVMMetadata(backupObject)),
+		d.Set("backup_record_id", backupObject.CheckpointId),
+		d.Set("auto_trigger", backupObject.ExtendInfo.AutoTrigger),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving CSBS backup (%s) fields: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceCSBSBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.CsbsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSBS v1 client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    backupDeleteRefreshFunc(client, d.Id(), d.Get("backup_record_id").(string)),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error deleting CSBS backup (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
+func backupStatusRefreshFunc(client *golangsdk.ServiceClient, backupId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := backup.Get(client, backupId).ExtractBackup()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp.Status == "error" {
+			return resp, "", fmt.Errorf("unexpected status '%s'", resp.Status)
+		}
+		return resp, resp.Status, nil
+	}
+}
+
+// backupDeleteRefreshFunc keeps sending the delete request until the backup is gone, because the backup cannot be
+// deleted while it is being used by other operations (the API returns 400 or 409).
+func backupDeleteRefreshFunc(client *golangsdk.ServiceClient, backupId, checkpointId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := backup.Get(client, backupId).ExtractBackup()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[INFO] successfully deleted CSBS backup (%s)", backupId)
+				return "", "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		err = backup.Delete(client, checkpointId).Err
+		if err != nil {
+			switch errCode := err.(type) {
+			case golangsdk.ErrDefault404:
+				return "", "deleted", nil
+			case golangsdk.ErrDefault400:
+				return resp, "deleting", nil
+			case golangsdk.ErrUnexpectedResponseCode:
+				if errCode.Actual == 409 {
+					return resp, "deleting", nil
+				}
+			}
+			return nil, "", err
+		}
+		return resp, resp.Status, nil
+	}
+}
+
+func flattenCSBSVolumeBackups(backupObject *backup.Backup) []map[string]interface{} {
+	var volumeBackups []map[string]interface{}
+
+	for _, volume := range backupObject.ExtendInfo.VolumeBackups {
+		mapping := map[string]interface{}{
+			"status":             volume.Status,
+			"space_saving_ratio": volume.SpaceSavingRatio,
+			"name":               volume.Name,
+			"bootable":           volume.Bootable,
+			"average_speed":      volume.AverageSpeed,
+			"source_volume_size": volume.SourceVolumeSize,
+			"source_volume_id":   volume.SourceVolumeId,
+			"snapshot_id":        volume.SnapshotID,
+			"incremental":        volume.Incremental,
+			"source_volume_name": volume.SourceVolumeName,
+			"image_type":         volume.ImageType,
+			"id":                 volume.Id,
+			"size":               volume.Size,
+		}
+		volumeBackups = append(volumeBackups, mapping)
+	}
+
+	return volumeBackups
+}
+
+func flattenCSBSVMMetadata(backupObject *backup.Backup) []map[string]interface{} {
+	mapping := map[string]interface{}{
+		"name":               backupObject.ExtendInfo.ResourceName,
+		"eip":                backupObject.VMMetadata.Eip,
+		"cloud_service_type": backupObject.VMMetadata.CloudServiceType,
+		"ram":                backupObject.VMMetadata.Ram,
+		"vcpus":              backupObject.VMMetadata.Vcpus,
+		"private_ip":         backupObject.VMMetadata.PrivateIp,
+		"disk":               backupObject.VMMetadata.Disk,
+		"image_type":         backupObject.VMMetadata.ImageType,
+	}
+
+	return []map[string]interface{}{mapping}
+}
@@ -0,0 +1,430 @@
+package csbs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/csbs/v1/policies"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+)
+
+// ResourceCSBSBackupPolicy is the impl of hcs_csbs_backup_policy
+func ResourceCSBSBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCSBSBackupPolicyCreate,
+		ReadContext:   resourceCSBSBackupPolicyRead,
+		UpdateContext: resourceCSBSBackupPolicyUpdate,
+		DeleteContext: resourceCSBSBackupPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"provider_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "fc4d5750-22e7-4798-8a46-f48f62c4c1da",
+				ForceNew: true,
+			},
+			"common": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scheduled_operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"max_backups": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"retention_duration_days": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"permanent": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"trigger_pattern": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"operation_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trigger_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trigger_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trigger_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resource": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCSBSBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.CsbsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSBS v1 client: %s", err)
+	}
+
+	createOpts := policies.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ProviderId:  d.Get("provider_id").(string),
+		Parameters: policies.PolicyParam{
+			Common: buildCSBSCommonParams(d),
+		},
+		ScheduledOperations: buildCSBSScheduledOperations(d),
+		Resources:           buildCSBSPolicyResources(d),
+	}
+	backupPolicy, err := policies.Create(client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating CSBS backup policy: %s", err)
+	}
+	d.SetId(backupPolicy.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"suspended"},
+		Refresh:    backupPolicyStatusRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for CSBS backup policy (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceCSBSBackupPolicyRead(ctx, d, meta)
+}
+
+func resourceCSBSBackupPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CsbsV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSBS v1 client: %s", err)
+	}
+
+	backupPolicy, err := policies.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "CSBS backup policy")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", backupPolicy.Name),
+		d.Set("description", backupPolicy.Description),
+		d.Set("provider_id", backupPolicy.ProviderId),
+		d.Set("common", backupPolicy.Parameters.Common),
+		d.Set("status", backupPolicy.Status),
+		d.Set("created_at", backupPolicy.CreatedAt.Format(time.RFC3339)),
+		d.Set("resource", flattenCSBSPolicyResources(*backupPolicy)),
+		d.Set("scheduled_operation", flattenCSBSScheduledOperations(*backupPolicy)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving CSBS backup policy (%s) fields: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceCSBSBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.CsbsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSBS v1 client: %s", err)
+	}
+
+	var updateOpts policies.UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		updateOpts.Description = d.Get("description").(string)
+	}
+	updateOpts.Parameters.Common = buildCSBSCommonParams(d)
+	if d.HasChange("resource") {
+		updateOpts.Resources = buildCSBSPolicyResources(d)
+	}
+	if d.HasChange("scheduled_operation") {
+		updateOpts.ScheduledOperations = buildCSBSScheduledOperationsToUpdate(d)
+	}
+
+	_, err = policies.Update(client, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error updating CSBS backup policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceCSBSBackupPolicyRead(ctx, d, meta)
+}
+
+func resourceCSBSBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.CsbsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSBS v1 client: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available"},
+		Target:     []string{"deleted"},
+		Refresh:    backupPolicyDeleteRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error deleting CSBS backup policy (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
+func backupPolicyStatusRefreshFunc(client *golangsdk.ServiceClient, policyId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := policies.Get(client, policyId).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if resp.Status == "error" {
+			return resp, "", fmt.Errorf("unexpected status '%s'", resp.Status)
+		}
+		return resp, resp.Status, nil
+	}
+}
+
+// backupPolicyDeleteRefreshFunc keeps sending the delete request until the policy is gone, because the policy cannot
+// be deleted while its backup is executing (the API returns 409).
+func backupPolicyDeleteRefreshFunc(client *golangsdk.ServiceClient, policyId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := policies.Get(client, policyId).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[INFO] successfully deleted CSBS backup policy (%s)", policyId)
+				return "", "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		err = policies.Delete(client, policyId).Err
+		if err != nil {
+			switch errCode := err.(type) {
+			case golangsdk.ErrDefault404:
+				return "", "deleted", nil
+			case golangsdk.ErrUnexpectedResponseCode:
+				if errCode.Actual == 409 {
+					return resp, "available", nil
+				}
+			}
+			return nil, "", err
+		}
+		return resp, "deleted", nil
+	}
+}
+
+func buildCSBSScheduledOperations(d *schema.ResourceData) []policies.ScheduledOperation {
+	scheduledOperations := d.Get("scheduled_operation").([]interface{})
+	so := make([]policies.ScheduledOperation, len(scheduledOperations))
+	for i, raw := range scheduledOperations {
+		rawMap := raw.(map[string]interface{})
+		so[i] = policies.ScheduledOperation{
+			Name:          rawMap["name"].(string),
+			Description:   rawMap["description"].(string),
+			Enabled:       rawMap["enabled"].(bool),
+			OperationType: rawMap["operation_type"].(string),
+			Trigger: policies.Trigger{
+				Properties: policies.TriggerProperties{
+					Pattern: rawMap["trigger_pattern"].(string),
+				},
+			},
+			OperationDefinition: policies.OperationDefinition{
+				MaxBackups:            rawMap["max_backups"].(int),
+				RetentionDurationDays: rawMap["retention_duration_days"].(int),
+				Permanent:             rawMap["permanent"].(bool),
+			},
+		}
+	}
+
+	return so
+}
+
+func buildCSBSPolicyResources(d *schema.ResourceData) []policies.Resource {
+	resources := d.Get("resource").(*schema.Set).List()
+	res := make([]policies.Resource, len(resources))
+	for i, raw := range resources {
+		rawMap := raw.(map[string]interface{})
+		res[i] = policies.Resource{
+			Name: rawMap["name"].(string),
+			Id:   rawMap["id"].(string),
+			Type: rawMap["type"].(string),
+		}
+	}
+	return res
+}
+
+func buildCSBSScheduledOperationsToUpdate(d *schema.ResourceData) []policies.ScheduledOperationToUpdate {
+	oldSORaw, newSORaw := d.GetChange("scheduled_operation")
+	oldSOList := oldSORaw.([]interface{})
+	newSOSetList := newSORaw.([]interface{})
+
+	schedule := make([]policies.ScheduledOperationToUpdate, len(newSOSetList))
+	for i, raw := range newSOSetList {
+		rawNewMap := raw.(map[string]interface{})
+		rawOldMap := oldSOList[i].(map[string]interface{})
+		schedule[i] = policies.ScheduledOperationToUpdate{
+			Id:          rawOldMap["id"].(string),
+			Name:        rawNewMap["name"].(string),
+			Description: rawNewMap["description"].(string),
+			Enabled:     rawNewMap["enabled"].(bool),
+			Trigger: policies.Trigger{
+				Properties: policies.TriggerProperties{
+					Pattern: rawNewMap["trigger_pattern"].(string),
+				},
+			},
+			OperationDefinition: policies.OperationDefinition{
+				MaxBackups:            rawNewMap["max_backups"].(int),
+				RetentionDurationDays: rawNewMap["retention_duration_days"].(int),
+				Permanent:             rawNewMap["permanent"].(bool),
+			},
+		}
+	}
+
+	return schedule
+}
+
+func buildCSBSCommonParams(d *schema.ResourceData) map[string]string {
+	m := make(map[string]string)
+	for key, val := range d.Get("common").(map[string]interface{}) {
+		m[key] = val.(string)
+	}
+	return m
+}
+
+func flattenCSBSScheduledOperations(backupPolicy policies.BackupPolicy) []map[string]interface{} {
+	var scheduledOperationList []map[string]interface{}
+	for _, schedule := range backupPolicy.ScheduledOperations {
+		mapping := map[string]interface{}{
+			"enabled":                 schedule.Enabled,
+			"trigger_id":              schedule.TriggerID,
+			"name":                    schedule.Name,
+			"description":             schedule.Description,
+			"operation_type":          schedule.OperationType,
+			"max_backups":             schedule.OperationDefinition.MaxBackups,
+			"retention_duration_days": schedule.OperationDefinition.RetentionDurationDays,
+			"permanent":               schedule.OperationDefinition.Permanent,
+			"trigger_name":            schedule.Trigger.Name,
+			"trigger_type":            schedule.Trigger.Type,
+			"trigger_pattern":         schedule.Trigger.Properties.Pattern,
+			"id":                      schedule.ID,
+		}
+		scheduledOperationList = append(scheduledOperationList, mapping)
+	}
+
+	return scheduledOperationList
+}
+
+func flattenCSBSPolicyResources(backupPolicy policies.BackupPolicy) []map[string]interface{} {
+	var resourceList []map[string]interface{}
+	for _, resources := range backupPolicy.Resources {
+		mapping := map[string]interface{}{
+			"id":   resources.Id,
+			"type": resources.Type,
+			"name": resources.Name,
+		}
+		resourceList = append(resourceList, mapping)
+	}
+
+	return resourceList
+}
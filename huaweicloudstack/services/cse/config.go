@@ -0,0 +1,35 @@
+package cse
+
+import (
+	"fmt"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/cse/dedicated/v4/auth"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+)
+
+// getToken is a method to request the CSE API and get the authorization token.
+// The format of is "Bearer {token}".
+func getToken(c *golangsdk.ServiceClient, username, password string) (string, error) {
+	tokenOpts := auth.CreateOpts{
+		Name:     username,
+		Password: password,
+	}
+	resp, err := auth.Create(c, tokenOpts)
+	if err != nil {
+		return "", fmt.Errorf("unable to create the authorization token: %v", err)
+	}
+
+	return fmt.Sprintf("Bearer %s", resp.Token), nil
+}
+
+// GetAuthorizationToken is a method for creating an authorization information for a CSE microservice to connect to the
+// specified dedicated engine.
+func GetAuthorizationToken(connAddr, username, password string) (string, error) {
+	if username == "" {
+		return "", nil
+	}
+	client := common.NewCustomClient(true, connAddr, "v4")
+	return getToken(client, username, password)
+}
@@ -0,0 +1,241 @@
+package dc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dc/v3/connections"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+func DataSourceDirectConnections() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDirectConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the direct connections are located.",
+			},
+			"connection_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the direct connection to be queried.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the direct connection to be queried.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The status of the direct connections to be queried.",
+			},
+			"port_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The port type of the direct connections to be queried.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The enterprise project ID of the direct connections to be queried.",
+			},
+			"connections": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        directConnectionSchema(),
+				Description: "All direct connections that match the filter parameters.",
+			},
+		},
+	}
+}
+
+func directConnectionSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the direct connection.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the direct connection.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the direct connection.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the direct connection.",
+			},
+			"port_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the port used by the direct connection.",
+			},
+			"bandwidth": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The bandwidth of the direct connection, in Mbit/s.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The access location of the direct connection.",
+			},
+			"peer_location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The location of the on-premises facility at the other end of the direct connection.",
+			},
+			"device_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the device connected to the direct connection.",
+			},
+			"interface_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the interface on the device connected to the direct connection.",
+			},
+			"redundant_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the redundant direct connection.",
+			},
+			"provider_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The carrier who provides the leased line.",
+			},
+			"provider_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the carrier's leased line.",
+			},
+			"hosting_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the operations connection on which the hosted connection is created.",
+			},
+			"vlan": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The VLAN allocated to the hosted connection.",
+			},
+			"lag_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the link aggregation group to which the direct connection belongs.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the direct connection.",
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enterprise project ID to which the direct connection belongs.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The key/value pairs associated with the direct connection.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the direct connection.",
+			},
+		},
+	}
+}
+
+func buildDirectConnectionsListOpts(d *schema.ResourceData) connections.ListOpts {
+	opts := connections.ListOpts{}
+	if v, ok := d.GetOk("connection_id"); ok {
+		opts.IDs = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("name"); ok {
+		opts.Names = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("status"); ok {
+		opts.Status = []string{v.(string)}
+	}
+	if v, ok := d.GetOk("enterprise_project_id"); ok {
+		opts.EnterpriseProjectIds = []string{v.(string)}
+	}
+	return opts
+}
+
+func dataSourceDirectConnectionsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DcV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DC v3 client: %s", err)
+	}
+
+	resp, err := connections.List(client, buildDirectConnectionsListOpts(d))
+	if err != nil {
+		return diag.Errorf("error querying direct connections: %s", err)
+	}
+
+	portType := d.Get("port_type").(string)
+	ids := make([]string, 0, len(resp))
+	result := make([]map[string]interface{}, 0, len(resp))
+	for _, conn := range resp {
+		if portType != "" && conn.PortType != portType {
+			continue
+		}
+		ids = append(ids, conn.ID)
+		result = append(result, map[string]interface{}{
+			"id":                    conn.ID,
+			"name":                  conn.Name,
+			"description":           conn.Description,
+			"type":                  conn.Type,
+			"port_type":             conn.PortType,
+			"bandwidth":             conn.Bandwidth,
+			"location":              conn.Location,
+			"peer_location":         conn.PeerLocation,
+			"device_id":             conn.DeviceId,
+			"interface_name":        conn.InterfaceName,
+			"redundant_id":          conn.RedundantId,
+			"provider_name":         conn.Provider,
+			"provider_status":       conn.ProviderStatus,
+			"hosting_id":            conn.HostingId,
+			"vlan":                  conn.Vlan,
+			"lag_id":                conn.LagId,
+			"status":                conn.Status,
+			"enterprise_project_id": conn.EnterpriseProjectId,
+			"tags":                  utils.TagsToMap(conn.Tags),
+			"created_at":            conn.CreateTime,
+		})
+	}
+	d.SetId(hashcode.Strings(ids))
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("connections", result),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving direct connections fields: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,519 @@
+package dis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/dis/v2/dumptasks"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+func ResourceDisDumpTask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDumpTaskCreate,
+		ReadContext:   resourceDumpTaskRead,
+		DeleteContext: resourceDumpTaskDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDumpTaskImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: resourceDumpTaskDestinationDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"stream_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"task_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					dumptasks.DestinationTypeObs, dumptasks.DestinationTypeDws,
+				}, false),
+			},
+			"obs_destination": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				Elem:         obsDestinationSchema(),
+				ExactlyOneOf: []string{"obs_destination", "dws_destination"},
+			},
+			"dws_destination": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     dwsDestinationSchema(),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func obsDestinationSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"agency_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"obs_bucket_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"deliver_time_interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(30, 900),
+			},
+			"consumer_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"file_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"partition_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_file_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"record_delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dwsDestinationSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"agency_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"deliver_time_interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(30, 900),
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"delimiter": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"kms_user_key_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kms_user_key_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"obs_bucket_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"consumer_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"file_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"retry_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 7200),
+			},
+			"table_columns": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func buildObsDestinationOpts(taskName string, destinations []interface{}) *dumptasks.ObsDestinationDescriptorOpts {
+	if len(destinations) < 1 {
+		return nil
+	}
+
+	dest := destinations[0].(map[string]interface{})
+	return &dumptasks.ObsDestinationDescriptorOpts{
+		TaskName:            taskName,
+		AgencyName:          dest["agency_name"].(string),
+		DeliverTimeInterval: dest["deliver_time_interval"].(int),
+		ConsumerStrategy:    dest["consumer_strategy"].(string),
+		FilePrefix:          dest["file_prefix"].(string),
+		PartitionFormat:     dest["partition_format"].(string),
+		ObsBucketPath:       dest["obs_bucket_path"].(string),
+		DestinationFileType: dest["destination_file_type"].(string),
+		RecordDelimiter:     dest["record_delimiter"].(string),
+	}
+}
+
+func buildDwsDestinationOpts(d *schema.ResourceData, taskName string) *dumptasks.DwsDestinationDescriptorOpts {
+	destinations := d.Get("dws_destination").([]interface{})
+	if len(destinations) < 1 {
+		return nil
+	}
+
+	dest := destinations[0].(map[string]interface{})
+	opts := dumptasks.DwsDestinationDescriptorOpts{
+		TaskName:            taskName,
+		AgencyName:          dest["agency_name"].(string),
+		DeliverTimeInterval: dest["deliver_time_interval"].(int),
+		ConsumerStrategy:    dest["consumer_strategy"].(string),
+		DwsClusterName:      dest["cluster_name"].(string),
+		DwsClusterId:        dest["cluster_id"].(string),
+		DwsDatabaseName:     dest["database_name"].(string),
+		DwsSchema:           dest["schema"].(string),
+		DwsTableName:        dest["table_name"].(string),
+		DwsDelimiter:        dest["delimiter"].(string),
+		UserName:            dest["user_name"].(string),
+		UserPassword:        dest["user_password"].(string),
+		KmsUserKeyName:      dest["kms_user_key_name"].(string),
+		KmsUserKeyId:        dest["kms_user_key_id"].(string),
+		ObsBucketPath:       dest["obs_bucket_path"].(string),
+		FilePrefix:          dest["file_prefix"].(string),
+		DwsTableColumns:     dest["table_columns"].(string),
+	}
+	// The retry duration can be set to 0, which cannot be distinguished from an omitted value by GetOk, so check whether
+	// it is configured in the raw configuration.
+	if isRetryDurationConfigured(d) {
+		opts.RetryDuration = utils.Int(dest["retry_duration"].(int))
+	}
+	return &opts
+}
+
+// resourceDumpTaskDestinationDiff checks whether the destination block matches the destination type.
+func resourceDumpTaskDestinationDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	destinationBlocks := map[string]string{
+		dumptasks.DestinationTypeObs: "obs_destination",
+		dumptasks.DestinationTypeDws: "dws_destination",
+	}
+
+	destinationType := d.Get("destination_type").(string)
+	block, ok := destinationBlocks[destinationType]
+	if !ok {
+		// The destination type is unknown or invalid, which is checked by the validation.
+		return nil
+	}
+	if len(d.Get(block).([]interface{})) == 0 {
+		return fmt.Errorf("%s is required when destination_type is %s", block, destinationType)
+	}
+	return nil
+}
+
+func isRetryDurationConfigured(d *schema.ResourceData) bool {
+	rawDestinations := d.GetRawConfig().GetAttr("dws_destination")
+	if rawDestinations.IsNull() || !rawDestinations.IsKnown() {
+		return false
+	}
+
+	destinations := rawDestinations.AsValueSlice()
+	return len(destinations) > 0 && !destinations[0].GetAttr("retry_duration").IsNull()
+}
+
+func resourceDumpTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := config.GetHcsConfig(meta)
+	region := conf.GetRegion(d)
+	client, err := conf.DisV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DIS v2 client, err: %s", err)
+	}
+
+	streamName := d.Get("stream_name").(string)
+	taskName := d.Get("task_name").(string)
+	opts := dumptasks.CreateOpts{
+		DestinationType:          d.Get("destination_type").(string),
+		ObsDestinationDescriptor: buildObsDestinationOpts(taskName, d.Get("obs_destination").([]interface{})),
+		DwsDestinationDescriptor: buildDwsDestinationOpts(d, taskName),
+	}
+	log.Printf("[DEBUG] creating DIS dump task (%s) of the stream (%s)", taskName, streamName)
+	err = dumptasks.Create(client, streamName, opts)
+	if err != nil {
+		return diag.Errorf("error creating DIS dump task: %s", err)
+	}
+
+	task, err := waitForDumpTaskRunning(ctx, client, streamName, taskName, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(task.TaskId)
+
+	return resourceDumpTaskRead(ctx, d, meta)
+}
+
+func waitForDumpTaskRunning(ctx context.Context, client *golangsdk.ServiceClient, streamName, taskName string,
+	timeout time.Duration) (*dumptasks.DumpTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{dumptasks.StateStarting},
+		Target:  []string{dumptasks.StateRunning},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := dumptasks.Get(client, streamName, taskName)
+			if err != nil {
+				return nil, "", err
+			}
+			if resp.State == dumptasks.StateError || resp.State == dumptasks.StateAbnormal {
+				return resp, "", fmt.Errorf("unexpected status: %s", resp.State)
+			}
+			return resp, resp.State, nil
+		},
+		Timeout:      timeout,
+		PollInterval: 5 * time.Second,
+		Delay:        5 * time.Second,
+	}
+	task, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for DIS dump task (%s) to become running: %s", taskName, err)
+	}
+	return task.(*dumptasks.DumpTask), nil
+}
+
+func flattenObsDestination(dest dumptasks.ObsDestinationDescriptor) []map[string]interface{} {
+	if dest.ObsBucketPath == "" {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"agency_name":           dest.AgencyName,
+			"obs_bucket_path":       dest.ObsBucketPath,
+			"deliver_time_interval": dest.DeliverTimeInterval,
+			"consumer_strategy":     dest.ConsumerStrategy,
+			"file_prefix":           dest.FilePrefix,
+			"partition_format":      dest.PartitionFormat,
+			"destination_file_type": dest.DestinationFileType,
+			"record_delimiter":      dest.RecordDelimiter,
+		},
+	}
+}
+
+func flattenDwsDestination(d *schema.ResourceData, dest dumptasks.DwsDestinationDescriptor) []map[string]interface{} {
+	if dest.DwsClusterId == "" {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"agency_name":           dest.AgencyName,
+			"deliver_time_interval": dest.DeliverTimeInterval,
+			"cluster_name":          dest.DwsClusterName,
+			"cluster_id":            dest.DwsClusterId,
+			"database_name":         dest.DwsDatabaseName,
+			"schema":                dest.DwsSchema,
+			"table_name":            dest.DwsTableName,
+			"delimiter":             dest.DwsDelimiter,
+			"user_name":             dest.UserName,
+			// The password is not returned by the API, so keep the value in the configuration.
+			"user_password":     d.Get("dws_destination.0.user_password"),
+			"kms_user_key_name": dest.KmsUserKeyName,
+			"kms_user_key_id":   dest.KmsUserKeyId,
+			"obs_bucket_path":   dest.ObsBucketPath,
+			"consumer_strategy": dest.ConsumerStrategy,
+			"file_prefix":       dest.FilePrefix,
+			"retry_duration":    dest.RetryDuration,
+			"table_columns":     dest.DwsTableColumns,
+		},
+	}
+}
+
+func resourceDumpTaskRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := config.GetHcsConfig(meta)
+	region := conf.GetRegion(d)
+	client, err := conf.DisV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DIS v2 client, err: %s", err)
+	}
+
+	streamName := d.Get("stream_name").(string)
+	taskName := d.Get("task_name").(string)
+	task, err := dumptasks.Get(client, streamName, taskName)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error querying DIS dump task")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("stream_name", streamName),
+		d.Set("task_name", task.TaskName),
+		d.Set("destination_type", task.DestinationType),
+		d.Set("obs_destination", flattenObsDestination(task.ObsDestinationDescription)),
+		d.Set("dws_destination", flattenDwsDestination(d, task.DwsDestinationDescription)),
+		d.Set("status", task.State),
+		// The unit of the creation time is millisecond.
+		d.Set("created_at", utils.FormatTimeStampRFC3339(task.CreateTime/1000, false)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting DIS dump task fields: %s", err)
+	}
+	return nil
+}
+
+func resourceDumpTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := config.GetHcsConfig(meta)
+	region := conf.GetRegion(d)
+	client, err := conf.DisV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DIS v2 client, err: %s", err)
+	}
+
+	streamName := d.Get("stream_name").(string)
+	taskName := d.Get("task_name").(string)
+	err = dumptasks.Delete(client, streamName, taskName)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DIS dump task")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := dumptasks.Get(client, streamName, taskName)
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "DELETED", nil
+				}
+				return nil, "", err
+			}
+			return resp, "PENDING", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		PollInterval: 5 * time.Second,
+		Delay:        5 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for DIS dump task (%s) to be deleted: %s", taskName, err)
+	}
+	return nil
+}
+
+func resourceDumpTaskImportState(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData,
+	error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <stream_name>/<task_name>")
+	}
+
+	conf := config.GetHcsConfig(meta)
+	client, err := conf.DisV2Client(conf.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating DIS v2 client, err: %s", err)
+	}
+	task, err := dumptasks.Get(client, parts[0], parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("error querying DIS dump task (%s): %s", d.Id(), err)
+	}
+	d.SetId(task.TaskId)
+
+	mErr := multierror.Append(nil,
+		d.Set("stream_name", parts[0]),
+		d.Set("task_name", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
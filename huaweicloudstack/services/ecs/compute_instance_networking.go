@@ -31,6 +31,7 @@ type InstanceNIC struct {
 	FixedIPv6       string
 	MAC             string
 	SourceDestCheck bool
+	SecurityGroups  []string
 	Fetched         bool
 }
 
@@ -104,6 +105,7 @@ func getInstanceAddresses(d *schema.ResourceData, meta interface{}, server *clou
 				PortID:          addr.PortID,
 				MAC:             addr.MacAddr,
 				SourceDestCheck: len(p.AllowedAddressPairs) == 0,
+				SecurityGroups:  p.SecurityGroups,
 			}
 
 			for _, portIP := range p.FixedIps {
@@ -174,14 +176,15 @@ func flattenInstanceNetworks(d *schema.ResourceData, meta interface{},
 
 			if isExist {
 				v := map[string]interface{}{
-					"uuid":              nic.NetworkID,
-					"port":              nic.PortID,
-					"fixed_ip_v4":       nic.FixedIPv4,
-					"fixed_ip_v6":       nic.FixedIPv6,
-					"ipv6_enable":       nic.FixedIPv6 != "",
-					"source_dest_check": nic.SourceDestCheck,
-					"mac":               nic.MAC,
-					"access_network":    instanceNetwork.AccessNetwork,
+					"uuid":               nic.NetworkID,
+					"port":               nic.PortID,
+					"fixed_ip_v4":        nic.FixedIPv4,
+					"fixed_ip_v6":        nic.FixedIPv6,
+					"ipv6_enable":        nic.FixedIPv6 != "",
+					"source_dest_check":  nic.SourceDestCheck,
+					"security_group_ids": nic.SecurityGroups,
+					"mac":                nic.MAC,
+					"access_network":     instanceNetwork.AccessNetwork,
 				}
 				networks = append(networks, v)
 				break
@@ -18,6 +18,7 @@ import (
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/hashcode"
 	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/compute/v2/extensions/attachinterfaces"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/compute/v2/extensions/secgroups"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/ecs/v1/block_devices"
 	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/ecs/v1/cloudservers"
@@ -57,6 +58,8 @@ func ResourceComputeInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: resourceComputeInstanceNetworkDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -147,36 +150,33 @@ func ResourceComputeInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			// The NICs can be appended to or removed from the tail of the list in place, the changes of the existing NICs
+			// which cannot be updated will force a new instance, see resourceComputeInstanceNetworkDiff.
 			"network": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 12,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"uuid": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							ForceNew:    true,
 							Computed:    true,
 							Description: "schema: Required",
 						},
 						"port": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							ForceNew:    true,
 							Computed:    true,
 							Description: "schema: Computed",
 						},
 						"ipv6_enable": {
 							Type:     schema.TypeBool,
 							Optional: true,
-							ForceNew: true,
 						},
 						"fixed_ip_v4": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 							Computed: true,
 						},
 						"source_dest_check": {
@@ -184,10 +184,16 @@ func ResourceComputeInstance() *schema.Resource {
 							Optional: true,
 							Default:  true,
 						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
 						"fixed_ip_v6": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							ForceNew:    true,
 							Computed:    true,
 							Description: "schema: Computed",
 						},
@@ -583,16 +589,14 @@ func resourceComputeInstanceCreate(ctx context.Context, d *schema.ResourceData,
 	}
 	d.SetId(serverId.(string))
 
-	// get the original value of source_dest_check in script
+	// get the original value of source_dest_check and security_group_ids in script
 	originalNetworks := d.Get("network").([]interface{})
-	sourceDestChecks := make([]bool, len(originalNetworks))
 	var flag bool
 
-	for i, v := range originalNetworks {
-		nic := v.(map[string]interface{})
-		sourceDestChecks[i] = nic["source_dest_check"].(bool)
-		if !flag && !sourceDestChecks[i] {
+	for _, v := range originalNetworks {
+		if shouldUpdateInstancePort(v.(map[string]interface{})) {
 			flag = true
+			break
 		}
 	}
 
@@ -609,14 +613,12 @@ func resourceComputeInstanceCreate(ctx context.Context, d *schema.ResourceData,
 
 		for i, nic := range networks {
 			nicPort := nic["port"].(string)
-			if nicPort == "" {
+			if nicPort == "" || i >= len(originalNetworks) {
 				continue
 			}
 
-			if !sourceDestChecks[i] {
-				if err := disableSourceDestCheck(nicClient, nicPort); err != nil {
-					return diag.Errorf("error disabling source dest check on port(%s) of instance(%s): %s", nicPort, d.Id(), err)
-				}
+			if err := updateInstancePort(nicClient, nicPort, originalNetworks[i].(map[string]interface{})); err != nil {
+				return diag.Errorf("error updating port(%s) of instance(%s): %s", nicPort, d.Id(), err)
 			}
 		}
 	}
@@ -889,9 +891,17 @@ func resourceComputeInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 			return diag.Errorf("error creating networking client: %s", err)
 		}
 
+		if err := updateInstanceNetworks(ctx, d, computeClient, nicClient); err != nil {
+			return diag.FromErr(err)
+		}
+
 		if err := updateSourceDestCheck(d, nicClient); err != nil {
 			return diag.FromErr(err)
 		}
+
+		if err := updatePortSecurityGroups(d, nicClient); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if d.HasChange("tags") {
@@ -1036,13 +1046,14 @@ func resourceComputeInstanceImportState(_ context.Context, d *schema.ResourceDat
 	networks := []map[string]interface{}{}
 	for _, nic := range allInstanceNics {
 		v := map[string]interface{}{
-			"uuid":              nic.NetworkID,
-			"port":              nic.PortID,
-			"fixed_ip_v4":       nic.FixedIPv4,
-			"fixed_ip_v6":       nic.FixedIPv6,
-			"ipv6_enable":       nic.FixedIPv6 != "",
-			"source_dest_check": nic.SourceDestCheck,
-			"mac":               nic.MAC,
+			"uuid":               nic.NetworkID,
+			"port":               nic.PortID,
+			"fixed_ip_v4":        nic.FixedIPv4,
+			"fixed_ip_v6":        nic.FixedIPv6,
+			"ipv6_enable":        nic.FixedIPv6 != "",
+			"source_dest_check":  nic.SourceDestCheck,
+			"security_group_ids": nic.SecurityGroups,
+			"mac":                nic.MAC,
 		}
 		networks = append(networks, v)
 	}
@@ -1470,6 +1481,164 @@ func updateSourceDestCheck(d *schema.ResourceData, client *golangsdk.ServiceClie
 	return nil
 }
 
+// resourceComputeInstanceNetworkDiff forces a new instance if any NIC that cannot be updated in place is changed.
+// The NICs are only appended to or removed from the tail of the network list, so the existing NICs must keep their
+// network, port and IP addresses.
+func resourceComputeInstanceNetworkDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("network") {
+		return nil
+	}
+
+	o, n := d.GetChange("network")
+	oldCount, newCount := len(o.([]interface{})), len(n.([]interface{}))
+	for i := 0; i < newCount; i++ {
+		if i >= oldCount {
+			// IPv6 is not supported by the NICs which are attached after the creation.
+			key := fmt.Sprintf("network.%d.ipv6_enable", i)
+			if d.Get(key).(bool) {
+				if err := d.ForceNew(key); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		for _, k := range []string{"uuid", "port", "fixed_ip_v4", "ipv6_enable", "fixed_ip_v6", "access_network"} {
+			key := fmt.Sprintf("network.%d.%s", i, k)
+			if d.HasChange(key) {
+				if err := d.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// updateInstanceNetworks detaches the NICs removed from the tail of the network list and attaches the new ones.
+func updateInstanceNetworks(ctx context.Context, d *schema.ResourceData, computeClient,
+	nicClient *golangsdk.ServiceClient) error {
+	o, n := d.GetChange("network")
+	oldNetworks, newNetworks := o.([]interface{}), n.([]interface{})
+
+	for i := len(newNetworks); i < len(oldNetworks); i++ {
+		portId := oldNetworks[i].(map[string]interface{})["port"].(string)
+		if portId == "" {
+			continue
+		}
+
+		log.Printf("[DEBUG] detaching port (%s) from instance (%s)", portId, d.Id())
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{""},
+			Target:     []string{"DETACHED"},
+			Refresh:    computeInterfaceAttachDetachFunc(computeClient, d.Id(), portId),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error detaching port (%s) from instance (%s): %s", portId, d.Id(), err)
+		}
+	}
+
+	for i := len(oldNetworks); i < len(newNetworks); i++ {
+		nic := newNetworks[i].(map[string]interface{})
+		attachOpts := attachinterfaces.CreateOpts{
+			PortID:    nic["port"].(string),
+			NetworkID: nic["uuid"].(string),
+		}
+		if attachOpts.PortID != "" {
+			// The network of the specified port is used.
+			attachOpts.NetworkID = ""
+		} else if fixedIP := nic["fixed_ip_v4"].(string); fixedIP != "" {
+			attachOpts.FixedIPs = []attachinterfaces.FixedIP{{IPAddress: fixedIP}}
+		}
+
+		log.Printf("[DEBUG] attaching interface to instance (%s): %#v", d.Id(), attachOpts)
+		attachment, err := attachinterfaces.Create(computeClient, d.Id(), attachOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("error attaching interface to instance (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"ATTACHING"},
+			Target:     []string{"ATTACHED"},
+			Refresh:    computeInterfaceAttachAttachFunc(computeClient, d.Id(), attachment.PortID),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for port (%s) to be attached to instance (%s): %s",
+				attachment.PortID, d.Id(), err)
+		}
+
+		if err = updateInstancePort(nicClient, attachment.PortID, nic); err != nil {
+			return fmt.Errorf("error updating port (%s) of instance (%s): %s", attachment.PortID, d.Id(), err)
+		}
+	}
+	return nil
+}
+
+// shouldUpdateInstancePort checks whether the port of the NIC should be updated after it is created or attached.
+func shouldUpdateInstancePort(nic map[string]interface{}) bool {
+	return !nic["source_dest_check"].(bool) || nic["security_group_ids"].(*schema.Set).Len() > 0
+}
+
+// updateInstancePort disables the source/destination check and updates the security groups of the new port if they
+// are specified in the script.
+func updateInstancePort(client *golangsdk.ServiceClient, portId string, nic map[string]interface{}) error {
+	if !shouldUpdateInstancePort(nic) {
+		return nil
+	}
+
+	var opts ports.UpdateOpts
+	if !nic["source_dest_check"].(bool) {
+		// Update the allowed-address-pairs of the port to 1.1.1.1/0
+		// to disable the source/destination check
+		opts.AllowedAddressPairs = &[]ports.AddressPair{
+			{
+				IPAddress: "1.1.1.1/0",
+			},
+		}
+	}
+	if secGroups := utils.ExpandToStringListBySet(nic["security_group_ids"].(*schema.Set)); len(secGroups) > 0 {
+		opts.SecurityGroups = &secGroups
+	}
+
+	_, err := ports.Update(client, portId, opts).Extract()
+	return err
+}
+
+func updatePortSecurityGroups(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	oldNetworks, _ := d.GetChange("network")
+	oldCount := len(oldNetworks.([]interface{}))
+
+	networks := d.Get("network").([]interface{})
+	for i, v := range networks {
+		// the security groups of the new NICs are updated after they are attached
+		if i >= oldCount {
+			break
+		}
+
+		nic := v.(map[string]interface{})
+		nicPort := nic["port"].(string)
+		if nicPort == "" || !d.HasChange(fmt.Sprintf("network.%d.security_group_ids", i)) {
+			continue
+		}
+
+		secGroups := utils.ExpandToStringListBySet(nic["security_group_ids"].(*schema.Set))
+		opts := ports.UpdateOpts{
+			SecurityGroups: &secGroups,
+		}
+		if _, err := ports.Update(client, nicPort, opts).Extract(); err != nil {
+			return fmt.Errorf("error updating security groups on port(%s) of instance(%s): %s", nicPort, d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
 func shouldUnsubscribeEIP(d *schema.ResourceData) bool {
 	deleteEIP := d.Get("delete_eip_on_termination").(bool)
 	eipAddr := d.Get("public_ip").(string)
@@ -0,0 +1,109 @@
+package ecs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testComputeInstanceNetworkConfig(networks ...map[string]interface{}) map[string]interface{} {
+	rawNetworks := make([]interface{}, len(networks))
+	for i, v := range networks {
+		rawNetworks[i] = v
+	}
+	return map[string]interface{}{
+		"name":    "test",
+		"network": rawNetworks,
+	}
+}
+
+// testComputeInstanceNetworkState builds the state of an existing instance with two NICs.
+func testComputeInstanceNetworkState(t *testing.T, r *schema.Resource) *terraform.InstanceState {
+	raw := testComputeInstanceNetworkConfig(
+		map[string]interface{}{"uuid": "subnet-1"},
+		map[string]interface{}{"uuid": "subnet-2"},
+	)
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId("instance-1")
+	err := d.Set("network", []map[string]interface{}{
+		{
+			"uuid":              "subnet-1",
+			"port":              "port-1",
+			"fixed_ip_v4":       "192.168.0.10",
+			"source_dest_check": true,
+		},
+		{
+			"uuid":              "subnet-2",
+			"port":              "port-2",
+			"fixed_ip_v4":       "192.168.1.10",
+			"source_dest_check": true,
+		},
+	})
+	assert.Nil(t, err)
+	return d.State()
+}
+
+func TestResourceComputeInstanceNetworkDiff(t *testing.T) {
+	r := ResourceComputeInstance()
+	state := testComputeInstanceNetworkState(t, r)
+
+	cases := []struct {
+		name     string
+		networks []map[string]interface{}
+		forceNew bool
+	}{
+		{
+			name: "change the network of an existing NIC",
+			networks: []map[string]interface{}{
+				{"uuid": "subnet-1"},
+				{"uuid": "subnet-3"},
+			},
+			forceNew: true,
+		},
+		{
+			name: "change the access network of an existing NIC",
+			networks: []map[string]interface{}{
+				{"uuid": "subnet-1", "access_network": true},
+				{"uuid": "subnet-2"},
+			},
+			forceNew: true,
+		},
+		{
+			name: "append a NIC with IPv6 enabled",
+			networks: []map[string]interface{}{
+				{"uuid": "subnet-1"},
+				{"uuid": "subnet-2"},
+				{"uuid": "subnet-3", "ipv6_enable": true},
+			},
+			forceNew: true,
+		},
+		{
+			name: "append a NIC",
+			networks: []map[string]interface{}{
+				{"uuid": "subnet-1"},
+				{"uuid": "subnet-2"},
+				{"uuid": "subnet-3"},
+			},
+			forceNew: false,
+		},
+		{
+			name: "remove the NIC from the tail",
+			networks: []map[string]interface{}{
+				{"uuid": "subnet-1"},
+			},
+			forceNew: false,
+		},
+	}
+
+	for _, c := range cases {
+		config := terraform.NewResourceConfigRaw(testComputeInstanceNetworkConfig(c.networks...))
+		diff, err := r.Diff(context.Background(), state, config, nil)
+		assert.Nil(t, err, c.name)
+		if assert.NotNil(t, diff, c.name) {
+			assert.Equal(t, c.forceNew, diff.RequiresNew(), c.name)
+		}
+	}
+}
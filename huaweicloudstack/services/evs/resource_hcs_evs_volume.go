@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -94,7 +95,6 @@ func ResourceEvsVolume() *schema.Resource {
 			"volume_type": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			"multiattach": {
@@ -117,6 +117,12 @@ func ResourceEvsVolume() *schema.Resource {
 			},
 			"bootable": {
 				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"readonly": {
+				Type:     schema.TypeBool,
+				Optional: true,
 				Computed: true,
 			},
 			"attachments": {
@@ -218,7 +224,7 @@ func resourceEvsVolumeCreate(ctx context.Context, d *schema.ResourceData, meta i
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	volume, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmtp.DiagErrorf(
 			"Error waiting for volume (%s) to become ready: %s",
@@ -237,6 +243,10 @@ func resourceEvsVolumeCreate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	if err = updateVolumeFlags(blockStorageClient, d, volume.(*volumes.Volume)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceEvsVolumeRead(ctx, d, meta)
 }
 
@@ -265,7 +275,7 @@ func resourceEvsVolumeRead(_ context.Context, d *schema.ResourceData, meta inter
 	d.Set("status", v.Status)
 	d.Set("created_at", v.CreatedAt)
 	d.Set("updated_at", v.UpdatedAt)
-	d.Set("metadata", v.Metadata)
+	d.Set("metadata", flattenVolumeMetadata(v.Metadata))
 	d.Set("multiattach", v.Multiattach)
 	d.Set("tags", v.Tags)
 	d.Set("enterprise_project_id", v.EnterpriseProjectID)
@@ -288,6 +298,16 @@ func resourceEvsVolumeRead(_ context.Context, d *schema.ResourceData, meta inter
 			v.ID)
 	}
 	d.Set("bootable", bootable)
+
+	// The read-only flag is stored in the volume metadata, and it's missing if the flag has never been updated.
+	var readonly bool
+	if flag, ok := v.Metadata["readonly"]; ok {
+		readonly, err = strconv.ParseBool(flag)
+		if err != nil {
+			return diag.Errorf("the readonly flag (%s) of volume (%s) cannot be converted to bool", flag, v.ID)
+		}
+	}
+	d.Set("readonly", readonly)
 	return nil
 }
 
@@ -321,7 +341,7 @@ func resourceEvsVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			Pending:    []string{"extending"},
 			Target:     []string{"available", "in-use"},
 			Refresh:    VolumeV2StateRefreshFunc(blockStorageClient, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			Delay:      10 * time.Second,
 			MinTimeout: 3 * time.Second,
 		}
@@ -333,6 +353,24 @@ func resourceEvsVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	if d.HasChange("volume_type") {
+		if err = updateVolumeType(ctx, blockStorageClient, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("bootable") {
+		if err = updateVolumeBootable(blockStorageClient, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("readonly") {
+		if err = updateVolumeReadonly(blockStorageClient, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		tagErr := utils.UpdateResourceTags(blockStorageClient, d, "cloudvolumes", d.Id())
 		if tagErr != nil {
@@ -348,6 +386,97 @@ func resourceEvsVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	return resourceEvsVolumeRead(ctx, d, meta)
 }
 
+// updateVolumeType changes the volume type with the on-demand migration policy, so the data is migrated to the
+// backend of the new type if the current backend does not support it.
+func updateVolumeType(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	opts := volumeactions.ChangeTypeOpts{
+		NewType:         d.Get("volume_type").(string),
+		MigrationPolicy: volumeactions.MigrationPolicyOnDemand,
+	}
+	err := volumeactions.ChangeType(client, d.Id(), opts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("error changing the type of volume (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"COMPLETED"},
+		Refresh:    volumeRetypeRefreshFunc(client, d.Id(), opts.NewType),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for the type of volume (%s) to be changed: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// volumeRetypeRefreshFunc keeps pending until the volume has the new type and is available or in use, the volume may
+// still be in the original status when the retype has not started yet.
+func volumeRetypeRefreshFunc(client *golangsdk.ServiceClient, volumeId, volumeType string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := volumes.Get(client, volumeId).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if strings.HasPrefix(v.Status, "error") {
+			return v, "", fmt.Errorf("unexpected status '%s'", v.Status)
+		}
+		if v.VolumeType == volumeType && (v.Status == "available" || v.Status == "in-use") {
+			return v, "COMPLETED", nil
+		}
+		return v, "PENDING", nil
+	}
+}
+
+// updateVolumeFlags updates the bootable and readonly flags of the created volume if they are configured and different
+// from the API values. The volume is created as writable, and only volumes created from an image are bootable by default.
+func updateVolumeFlags(client *golangsdk.ServiceClient, d *schema.ResourceData, volume *volumes.Volume) error {
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.GetAttr("bootable").IsNull() {
+		bootable, _ := strconv.ParseBool(volume.Bootable)
+		if d.Get("bootable").(bool) != bootable {
+			if err := updateVolumeBootable(client, d); err != nil {
+				return err
+			}
+		}
+	}
+	if !rawConfig.GetAttr("readonly").IsNull() {
+		readonly, _ := strconv.ParseBool(volume.Metadata["readonly"])
+		if d.Get("readonly").(bool) != readonly {
+			if err := updateVolumeReadonly(client, d); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func updateVolumeBootable(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	opts := volumeactions.BootableOpts{
+		Bootable: d.Get("bootable").(bool),
+	}
+	err := volumeactions.SetBootable(client, d.Id(), opts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("error updating the bootable flag of volume (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
+func updateVolumeReadonly(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	opts := volumeactions.ReadonlyOpts{
+		Readonly: d.Get("readonly").(bool),
+	}
+	err := volumeactions.UpdateReadonly(client, d.Id(), opts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("error updating the readonly flag of volume (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
 func resourceEvsVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := config.GetHcsConfig(meta)
 	blockStorageClient, err := cfg.BlockStorageV2Client(cfg.GetRegion(d))
@@ -428,6 +557,17 @@ func resourceEvsVolumeDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return nil
 }
 
+// flattenVolumeMetadata filters out the read-only flag, which is managed by the readonly parameter.
+func flattenVolumeMetadata(metadata map[string]string) map[string]string {
+	result := make(map[string]string)
+	for k, v := range metadata {
+		if k != "readonly" {
+			result[k] = v
+		}
+	}
+	return result
+}
+
 func resourceVolumeMetadataV2(d *schema.ResourceData) map[string]string {
 	m := make(map[string]string)
 	for key, val := range d.Get("metadata").(map[string]interface{}) {
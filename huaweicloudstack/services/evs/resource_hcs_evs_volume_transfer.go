@@ -0,0 +1,131 @@
+package evs
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/blockstorage/extensions/volumetransfers"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+// ResourceVolumeTransfer creates a transfer for a volume in the current project. The transfer is accepted by the
+// target project using the transfer ID and the authorization key, see ResourceVolumeTransferAccept.
+func ResourceVolumeTransfer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVolumeTransferCreate,
+		ReadContext:   resourceVolumeTransferRead,
+		DeleteContext: resourceVolumeTransferDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"auth_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVolumeTransferCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.BlockStorageV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating block storage client: %s", err)
+	}
+
+	opts := volumetransfers.CreateOpts{
+		VolumeID: d.Get("volume_id").(string),
+		Name:     d.Get("name").(string),
+	}
+	log.Printf("[DEBUG] The create options of the volume transfer is: %#v", opts)
+	transfer, err := volumetransfers.Create(client, opts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating volume transfer: %s", err)
+	}
+	d.SetId(transfer.ID)
+
+	// The authorization key is only returned in the creation response.
+	if err = d.Set("auth_key", transfer.AuthKey); err != nil {
+		return diag.Errorf("error setting the authorization key of the volume transfer: %s", err)
+	}
+
+	return resourceVolumeTransferRead(ctx, d, meta)
+}
+
+func resourceVolumeTransferRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.BlockStorageV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating block storage client: %s", err)
+	}
+
+	transfer, err := volumetransfers.Get(client, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok && d.Get("volume_id").(string) != "" {
+			// The transfer record is removed once it is accepted or cancelled, keep the resource to avoid creating a
+			// new transfer after the one-time transfer is consumed. The resource is only removed when importing.
+			log.Printf("[WARN] The volume transfer (%s) has been accepted or cancelled", d.Id())
+			return nil
+		}
+		return common.CheckDeletedDiag(d, err, "error retrieving volume transfer")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("volume_id", transfer.VolumeID),
+		d.Set("name", transfer.Name),
+		d.Set("created_at", utils.FormatTimeStampRFC3339(transfer.CreatedAt.Unix(), false)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting volume transfer fields: %s", err)
+	}
+	return nil
+}
+
+func resourceVolumeTransferDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.BlockStorageV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating block storage client: %s", err)
+	}
+
+	// Deleting an accepted transfer will not move the volume back, the record is only removed from the state.
+	err = volumetransfers.Delete(client, d.Id()).ExtractErr()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting volume transfer")
+	}
+	return nil
+}
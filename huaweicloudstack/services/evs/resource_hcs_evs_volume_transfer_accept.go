@@ -0,0 +1,100 @@
+package evs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/blockstorage/extensions/volumetransfers"
+)
+
+// ResourceVolumeTransferAccept accepts a volume transfer within the target project, the volume is moved to the current
+// project after the creation. It is an action resource and destroying it will not move the volume back.
+func ResourceVolumeTransferAccept() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVolumeTransferAcceptCreate,
+		ReadContext:   resourceVolumeTransferAcceptRead,
+		DeleteContext: resourceVolumeTransferAcceptDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"transfer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"auth_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVolumeTransferAcceptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.BlockStorageV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating block storage client: %s", err)
+	}
+
+	transferId := d.Get("transfer_id").(string)
+	opts := volumetransfers.AcceptOpts{
+		AuthKey: d.Get("auth_key").(string),
+	}
+	transfer, err := volumetransfers.Accept(client, transferId, opts).Extract()
+	if err != nil {
+		return diag.Errorf("error accepting volume transfer (%s): %s", transferId, err)
+	}
+	d.SetId(transferId)
+	d.Set("volume_id", transfer.VolumeID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"awaiting-transfer", "maintenance"},
+		Target:     []string{"available"},
+		Refresh:    VolumeV2StateRefreshFunc(client, transfer.VolumeID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for the volume (%s) to become available: %s", transfer.VolumeID, err)
+	}
+
+	return resourceVolumeTransferAcceptRead(ctx, d, meta)
+}
+
+func resourceVolumeTransferAcceptRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The transfer record no longer exists after the acceptance, and the transferred volume may be moved or deleted
+	// later, so there is nothing to refresh for the one-time acceptance.
+	cfg := config.GetHcsConfig(meta)
+	d.Set("region", cfg.GetRegion(d))
+	return nil
+}
+
+func resourceVolumeTransferAcceptDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("[WARN] The accepted volume transfer cannot be reverted, Terraform will only remove it from the state file.")
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,326 @@
+package maas
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/maas/v1/task"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+)
+
+// The status codes of the migration task.
+const (
+	taskStatusNotStarted = 0
+	taskStatusWaiting    = 1
+	taskStatusExecuting  = 2
+	// The task is paused or stopped, it will not continue without a manual operation.
+	taskStatusPaused    = 3
+	taskStatusFailed    = 4
+	taskStatusSucceeded = 5
+)
+
+func ResourceMaasTask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaasTaskCreate,
+		ReadContext:   resourceMaasTaskRead,
+		DeleteContext: resourceMaasTaskDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"src_node": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ak": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"sk": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"object_key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"cloud_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "Aliyun",
+						},
+					},
+				},
+			},
+			"dst_node": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ak": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"sk": {
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"object_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"enable_kms": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+			"thread_num": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"smn_info": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic_urn": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"trigger_conditions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"language": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildSrcNodeOpts(nodes []interface{}) task.SrcNodeOpts {
+	node := nodes[0].(map[string]interface{})
+	return task.SrcNodeOpts{
+		Region:    node["region"].(string),
+		AK:        node["ak"].(string),
+		SK:        node["sk"].(string),
+		ObjectKey: node["object_key"].(string),
+		Bucket:    node["bucket"].(string),
+		CloudType: node["cloud_type"].(string),
+	}
+}
+
+func buildDstNodeOpts(nodes []interface{}) task.DstNodeOpts {
+	node := nodes[0].(map[string]interface{})
+	return task.DstNodeOpts{
+		Region:    node["region"].(string),
+		AK:        node["ak"].(string),
+		SK:        node["sk"].(string),
+		ObjectKey: node["object_key"].(string),
+		Bucket:    node["bucket"].(string),
+	}
+}
+
+func buildSmnInfoOpts(smnInfos []interface{}) *task.SmnInfoOpts {
+	if len(smnInfos) < 1 {
+		return nil
+	}
+
+	smnInfo := smnInfos[0].(map[string]interface{})
+	return &task.SmnInfoOpts{
+		TopicUrn:          smnInfo["topic_urn"].(string),
+		Language:          smnInfo["language"].(string),
+		TriggerConditions: utils.ExpandToStringListBySet(smnInfo["trigger_conditions"].(*schema.Set)),
+	}
+}
+
+func resourceMaasTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.MaasV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating MAAS v1 client: %s", err)
+	}
+
+	createOpts := task.CreateOpts{
+		SrcNode:     buildSrcNodeOpts(d.Get("src_node").([]interface{})),
+		DstNode:     buildDstNodeOpts(d.Get("dst_node").([]interface{})),
+		EnableKMS:   utils.Bool(d.Get("enable_kms").(bool)),
+		ThreadNum:   d.Get("thread_num").(int),
+		Description: d.Get("description").(string),
+		SmnInfo:     buildSmnInfoOpts(d.Get("smn_info").([]interface{})),
+	}
+	// The source and destination nodes contain the access keys, so do not print the options.
+	resp, err := task.Create(client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating MAAS task: %s", err)
+	}
+
+	taskId := strconv.FormatInt(resp.ID, 10)
+	d.SetId(taskId)
+
+	if err = waitForTaskCompleted(ctx, client, taskId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceMaasTaskRead(ctx, d, meta)
+}
+
+func waitForTaskCompleted(ctx context.Context, client *golangsdk.ServiceClient, taskId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			strconv.Itoa(taskStatusNotStarted),
+			strconv.Itoa(taskStatusWaiting),
+			strconv.Itoa(taskStatusExecuting),
+		},
+		Target: []string{strconv.Itoa(taskStatusSucceeded)},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := task.Get(client, taskId).Extract()
+			if err != nil {
+				return nil, "", err
+			}
+			switch resp.Status {
+			case taskStatusFailed:
+				return resp, "", fmt.Errorf("the migration task failed, %d of %d objects are failed to migrate",
+					resp.FailNum, resp.TotalNum)
+			case taskStatusPaused:
+				return resp, "", fmt.Errorf("the migration task is paused or stopped")
+			}
+			return resp, strconv.Itoa(resp.Status), nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for MAAS task (%s) to complete: %s", taskId, err)
+	}
+	return nil
+}
+
+func resourceMaasTaskRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	region := cfg.GetRegion(d)
+	client, err := cfg.MaasV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating MAAS v1 client: %s", err)
+	}
+
+	resp, err := task.Get(client, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving MAAS task")
+	}
+	log.Printf("[DEBUG] Retrieved MAAS task (%s): %#v", d.Id(), resp)
+
+	// The access keys are not returned by the API, so the src_node and dst_node are kept as configured.
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", resp.Name),
+		d.Set("status", resp.Status),
+		d.Set("enable_kms", resp.EnableKMS),
+		d.Set("thread_num", resp.ThreadNum),
+		d.Set("description", resp.Description),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting MAAS task fields: %s", err)
+	}
+	return nil
+}
+
+func resourceMaasTaskDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.MaasV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating MAAS v1 client: %s", err)
+	}
+
+	err = task.Delete(client, d.Id()).ExtractErr()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting MAAS task")
+	}
+	return nil
+}
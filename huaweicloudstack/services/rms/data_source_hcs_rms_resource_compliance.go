@@ -0,0 +1,168 @@
+package rms
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/rms/v1/policystates"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/helper/hashcode"
+)
+
+func DataSourceResourceCompliance() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceResourceComplianceRead,
+
+		Schema: map[string]*schema.Schema{
+			"policy_assignment_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the policy assignment used to query the evaluation results.",
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the evaluated resource used to query the evaluation results.",
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the evaluated resource used to query the evaluation results.",
+			},
+			"compliance_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Compliant", "NonCompliant",
+				}, false),
+				Description: "The compliance state used to query the evaluation results.",
+			},
+			"value": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the evaluated resource.",
+						},
+						"resource_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the evaluated resource.",
+						},
+						"resource_provider": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cloud service name of the evaluated resource.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the evaluated resource.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region to which the evaluated resource belongs.",
+						},
+						"trigger_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The trigger type of the evaluation.",
+						},
+						"compliance_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The compliance state of the evaluated resource.",
+						},
+						"policy_assignment_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the policy assignment.",
+						},
+						"policy_assignment_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the policy assignment.",
+						},
+						"policy_definition_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the policy definition.",
+						},
+						"evaluation_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The evaluation time of the resource compliance.",
+						},
+					},
+				},
+				Description: "The evaluation result list of the resource compliance.",
+			},
+		},
+	}
+}
+
+func flattenPolicyStates(states []policystates.PolicyState) ([]map[string]interface{}, []string) {
+	result := make([]map[string]interface{}, len(states))
+	ids := make([]string, len(states))
+	for i, state := range states {
+		ids[i] = state.PolicyAssignmentId + "/" + state.ResourceId
+		result[i] = map[string]interface{}{
+			"resource_id":            state.ResourceId,
+			"resource_name":          state.ResourceName,
+			"resource_provider":      state.ResourceProvider,
+			"resource_type":          state.ResourceType,
+			"region":                 state.RegionId,
+			"trigger_type":           state.TriggerType,
+			"compliance_state":       state.ComplianceState,
+			"policy_assignment_id":   state.PolicyAssignmentId,
+			"policy_assignment_name": state.PolicyAssignmentName,
+			"policy_definition_id":   state.PolicyDefinitionId,
+			"evaluation_time":        state.EvaluationTime,
+		}
+	}
+	return result, ids
+}
+
+func dataSourceResourceComplianceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := config.GetHcsConfig(meta)
+	client, err := cfg.RmsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RMS v1 client: %s", err)
+	}
+
+	var (
+		domainId = cfg.DomainID
+		opts     = policystates.ListOpts{
+			ComplianceState: d.Get("compliance_state").(string),
+			ResourceId:      d.Get("resource_id").(string),
+			ResourceName:    d.Get("resource_name").(string),
+		}
+		states []policystates.PolicyState
+	)
+	if assignmentId, ok := d.GetOk("policy_assignment_id"); ok {
+		states, err = policystates.ListByAssignment(client, domainId, assignmentId.(string), opts)
+	} else if resourceId, ok := d.GetOk("resource_id"); ok {
+		states, err = policystates.ListByResource(client, domainId, resourceId.(string), opts)
+	} else {
+		states, err = policystates.List(client, domainId, opts)
+	}
+	if err != nil {
+		return diag.Errorf("error querying the resource compliance from server: %s", err)
+	}
+
+	value, ids := flattenPolicyStates(states)
+	d.SetId(hashcode.Strings(ids))
+
+	if err = d.Set("value", value); err != nil {
+		return diag.Errorf("error saving the resource compliance to state: %s", err)
+	}
+	return nil
+}
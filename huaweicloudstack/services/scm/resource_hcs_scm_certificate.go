@@ -0,0 +1,404 @@
+package scm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	golangsdk "github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/sdk/huaweicloud/openstack/scm/v3/certificates"
+
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/common"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/config"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils/fmtp"
+	"github.com/huaweicloud/terraform-provider-hcs/huaweicloudstack/utils/logp"
+)
+
+const (
+	maxErrorMessageLen = 200
+	ellipsisString     = "..."
+
+	targetServiceCdn        = "CDN"
+	targetServiceWaf        = "WAF"
+	targetServiceEnhanceElb = "Enhance_ELB"
+)
+
+func ResourceScmCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScmCertificateCreate,
+		UpdateContext: resourceScmCertificateUpdate,
+		ReadContext:   resourceScmCertificateRead,
+		DeleteContext: resourceScmCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"certificate_chain": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SuppressNewLineDiffs,
+			},
+			"private_key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: utils.SuppressNewLineDiffs,
+			},
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: utils.SuppressNewLineDiffs,
+			},
+			"target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								targetServiceCdn, targetServiceWaf, targetServiceEnhanceElb,
+							}, false),
+						},
+						"project": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					}},
+			},
+
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"push_support": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_before": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentifications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"record_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"record_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"record_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					}},
+			},
+		},
+	}
+}
+
+func resourceScmCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := config.GetHcsConfig(meta)
+	scmClient, err := config.ScmV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmtp.DiagErrorf("Error creating HuaweiCloudStack SCM client: %s", err)
+	}
+
+	importOpts := certificates.ImportOpts{
+		Name:             d.Get("name").(string),
+		Certificate:      d.Get("certificate").(string),
+		PrivateKey:       "***",
+		CertificateChain: d.Get("certificate_chain").(string),
+	}
+	logp.Printf("[DEBUG] Imported certificate options %s: %#v", d.Id(), importOpts)
+	importOpts.PrivateKey = d.Get("private_key").(string)
+
+	c, err := certificates.Import(scmClient, importOpts).Extract()
+	if err != nil {
+		return fmtp.DiagErrorf("Error importing certificate: %s", err)
+	}
+	// If all has been successful, set the ID on the resource
+	d.SetId(c.CertificateId)
+
+	// Get targets and push certificate to the target service.
+	// Each rotation of the certificate content imports a new certificate, which is pushed to all targets again.
+	targets := d.Get("target").([]interface{})
+	logp.Printf("[DEBUG] SCM Certificate target: %#v", targets)
+	if err = pushCertificateToTargets(scmClient, d.Id(), targets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScmCertificateRead(ctx, d, meta)
+}
+
+// pushCertificateToTargets pushes the certificate to all projects of the target services.
+func pushCertificateToTargets(c *golangsdk.ServiceClient, id string, targets []interface{}) error {
+	pushCert, err := parsePushCertificateToMap(targets)
+	if err != nil {
+		return err
+	}
+
+	for service, projects := range pushCert {
+		if strings.Compare(service, targetServiceCdn) == 0 {
+			pushOpts := certificates.PushOpts{
+				TargetService: service,
+			}
+			logp.Printf("[DEBUG] Push certificate to CDN Service. %#v", pushOpts)
+			if err := pushCertificateToService(id, pushOpts, c); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if projects.Len() == 0 {
+			return fmtp.Errorf("the argument of \"project\" cannot be empty when pushed to the %s service", service)
+		}
+		for _, project := range projects.List() {
+			pushOpts := certificates.PushOpts{
+				TargetProject: project.(string),
+				TargetService: service,
+			}
+			logp.Printf("[DEBUG] Push certificate to services. %#v", pushOpts)
+			if err := pushCertificateToService(id, pushOpts, c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resourceScmCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := config.GetHcsConfig(meta)
+	scmClient, err := config.ScmV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmtp.DiagErrorf("Error creating HuaweiCloudStack SCM client: %s", err)
+	}
+
+	oldVal, newVal := d.GetChange("target")
+	newPushCert, err := parsePushCertificateToMap(newVal.([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	oldPushCert, _ := parsePushCertificateToMap(oldVal.([]interface{}))
+
+	// A pushed certificate cannot be withdrawn from the service, so the removed targets are only removed from the state.
+	var diags diag.Diagnostics
+	for service, oldProjects := range oldPushCert {
+		newProjects, ok := newPushCert[service]
+		if !ok || oldProjects.Difference(newProjects).Len() > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "The certificate pushed to the removed target is not withdrawn",
+				Detail: fmt.Sprintf("The certificate (%s) which has been pushed to the service (%s) is still kept, "+
+					"please delete it from the service manually if necessary.", d.Id(), service),
+			})
+		}
+	}
+
+	// extract the new push service
+	for service, newProjects := range newPushCert {
+		oldProjects, ok := oldPushCert[service]
+		if strings.Compare(service, targetServiceCdn) == 0 && !ok {
+			pushOpts := certificates.PushOpts{
+				TargetService: service,
+			}
+			logp.Printf("[DEBUG] Find new services and start to push. %#v", pushOpts)
+			err := pushCertificateToService(d.Id(), pushOpts, scmClient)
+			if err != nil {
+				d.Set("target", oldVal)
+				return diag.FromErr(err)
+			}
+		} else {
+			projectToAdd := newProjects
+			if oldProjects != nil {
+				projectToAdd = newProjects.Difference(oldProjects)
+			}
+			logp.Printf("[DEBUG] Find new services to push. %s: %#v", service, projectToAdd)
+			for _, project := range projectToAdd.List() {
+				pushOpts := certificates.PushOpts{
+					TargetProject: project.(string),
+					TargetService: service,
+				}
+				err := pushCertificateToService(d.Id(), pushOpts, scmClient)
+				if err != nil {
+					d.Set("target", oldVal)
+					return diag.FromErr(err)
+				}
+				logp.Printf("[DEBUG] Successfully push the certificate to the %s of %s.", service, project)
+			}
+		}
+	}
+
+	return append(diags, resourceScmCertificateRead(ctx, d, meta)...)
+}
+
+func pushCertificateToService(id string, pushOpts certificates.PushOpts, scmClient *golangsdk.ServiceClient) error {
+	if strings.Compare(pushOpts.TargetService, targetServiceCdn) != 0 && len(pushOpts.TargetProject) == 0 {
+		return fmtp.Errorf("the argument of \"project\" cannot be empty, "+
+			"it can be empty when pushed to the CDN service only. "+
+			"\r\ncertificate_id: %s, service: %s", id, pushOpts.TargetService)
+	}
+	err := certificates.Push(scmClient, id, pushOpts).ExtractErr()
+	if err != nil {
+		// Parse 'err' to print more error messages.
+		errMsg := processErr(err)
+		return fmtp.Errorf(errMsg)
+	}
+	return nil
+}
+
+func resourceScmCertificateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := config.GetHcsConfig(meta)
+	scmClient, err := config.ScmV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmtp.DiagErrorf("Error creating HuaweiCloudStack SCM client: %s", err)
+	}
+	certDetail, err := certificates.Get(scmClient, d.Id()).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "Error obtain certificate information")
+	}
+	logp.Printf("[DEBUG] Retrieved certificate %s: %#v", d.Id(), certDetail)
+
+	// convert the type of 'certDetail.Authentifications' to TypeList
+	auths := buildAuthtificatesAttribute(certDetail.Authentifications)
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("status", certDetail.Status),
+		d.Set("name", certDetail.Name),
+		d.Set("push_support", certDetail.PushSupport),
+		d.Set("authentifications", auths),
+		d.Set("domain", certDetail.Domain),
+		d.Set("domain_count", certDetail.DomainCount),
+		d.Set("not_before", certDetail.NotBefore),
+		d.Set("not_after", certDetail.NotAfter),
+	)
+
+	if err = mErr.ErrorOrNil(); err != nil {
+		return fmtp.DiagErrorf("error setting SCM certificate attributes: %s", err)
+	}
+
+	return nil
+}
+
+func buildAuthtificatesAttribute(authentifications []certificates.Authentification) []map[string]interface{} {
+	auth := make([]map[string]interface{}, 0, len(authentifications))
+	for _, v := range authentifications {
+		a := map[string]interface{}{
+			"record_name":  v.RecordName,
+			"record_type":  v.RecordType,
+			"record_value": v.RecordValue,
+			"domain":       v.Domain,
+		}
+		auth = append(auth, a)
+	}
+	return auth
+}
+
+func resourceScmCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := config.GetHcsConfig(meta)
+	scmClient, err := config.ScmV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmtp.DiagErrorf("Error creating HuaweiCloudStack SCM client: %s", err)
+	}
+
+	logp.Printf("[DEBUG] Deleting certificate: %s", d.Id())
+	err = certificates.Delete(scmClient, d.Id()).ExtractErr()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "Error deleting certificate error")
+	}
+
+	return nil
+}
+
+func parsePushCertificateToMap(pushCertificate []interface{}) (map[string]*schema.Set, error) {
+	serviceMapping := map[string]*schema.Set{}
+
+	for _, pushInfo := range pushCertificate {
+		targetService := pushInfo.(map[string]interface{})["service"].(string)
+		targetProjectArr := pushInfo.(map[string]interface{})["project"].([]interface{})
+
+		projects, ok := serviceMapping[targetService]
+		if !ok {
+			projects = &schema.Set{F: schema.HashString}
+		}
+		for _, proj := range targetProjectArr {
+			projectName := proj.(string)
+			if projects.Contains(projectName) {
+				// if _, ok := projects[projectName]; ok {
+				return nil, fmtp.Errorf("There are duplicate projects for the same service!\n"+
+					"service = %s, project = %s.", targetService, projectName)
+			}
+			projects.Add(projectName)
+		}
+		serviceMapping[targetService] = projects
+
+		logp.Printf("[DEBUG] Push certificate service mapping: %#v", serviceMapping)
+	}
+	return serviceMapping, nil
+}
+
+func processErr(err error) string {
+	var errMsg string
+	if err500, ok := err.(golangsdk.ErrDefault500); ok {
+		errBody := string(err500.Body)
+		// Maybe the text in the body is very long, only 200 characters printed。
+		if len(errBody) >= maxErrorMessageLen {
+			errBody = errBody[0:maxErrorMessageLen] + ellipsisString
+		}
+		// If 'err' is an ErrDefault500 object, the following information will be printed.
+		logp.Printf("[ERROR] Push certificate service error. URL: %s, Body: %s",
+			err500.URL, errBody)
+		errMsg = fmt.Sprintf("Push certificate service error: "+
+			"Bad request with: [%s %s], error message: %s", err500.Method, err500.URL, errBody)
+	} else {
+		// If 'err' is other error object, the default information will be printed.
+		logp.Printf("[ERROR] Push certificate service error: %s, \n%#v", err.Error(), err)
+		errMsg = fmt.Sprintf("Push certificate service error: %s", err)
+	}
+	return errMsg
+}